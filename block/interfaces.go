@@ -0,0 +1,16 @@
+package block
+
+import "github.com/jordyf15/tweeter-api/models"
+
+type Usecase interface {
+	Block(blockerID, blockedID string) error
+	Unblock(blockerID, blockedID string) error
+}
+
+type Repository interface {
+	Create(block *models.Block) (bool, error)
+	Delete(blockerID, blockedID string) error
+	IsExist(blockerID, blockedID string) (bool, error)
+	IsBlockedBetween(userID, otherUserID string) (bool, error)
+	GetBlockedUserIDs(userID string) ([]string, error)
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/block"
+	"github.com/jordyf15/tweeter-api/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type blockRepository struct {
+	DB *gorm.DB
+}
+
+func NewBlockRepository(db *gorm.DB) block.Repository {
+	return &blockRepository{DB: db}
+}
+
+// Create inserts the block unless it already exists and removes the follows between both users in either
+// direction. The returned bool is false when the block already existed. The follower and following counts
+// are kept up to date by the follows triggers.
+func (repo *blockRepository) Create(block *models.Block) (bool, error) {
+	block.CreatedAt = time.Now()
+
+	isCreated := false
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(block)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)",
+			block.BlockerID, block.BlockedID, block.BlockedID, block.BlockerID).Delete(&models.Follow{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Table("follow_requests").Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)",
+			block.BlockerID, block.BlockedID, block.BlockedID, block.BlockerID).Delete(&models.Follow{}).Error
+		if err != nil {
+			return err
+		}
+
+		isCreated = true
+		return nil
+	})
+
+	return isCreated, err
+}
+
+func (repo *blockRepository) Delete(blockerID, blockedID string) error {
+	return repo.DB.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Delete(&models.Block{}).Error
+}
+
+func (repo *blockRepository) IsExist(blockerID, blockedID string) (bool, error) {
+	var count int64
+	err := repo.DB.Table("blocks").Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Count(&count).Error
+	return count > 0, err
+}
+
+// IsBlockedBetween reports whether either user has blocked the other.
+func (repo *blockRepository) IsBlockedBetween(userID, otherUserID string) (bool, error) {
+	var count int64
+	err := repo.DB.Table("blocks").
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherUserID, otherUserID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetBlockedUserIDs returns the IDs of the users blocked by the user together with the users who have blocked them.
+func (repo *blockRepository) GetBlockedUserIDs(userID string) ([]string, error) {
+	userIDs := make([]string, 0)
+	err := repo.DB.Raw("SELECT blocked_id FROM blocks WHERE blocker_id = ? UNION SELECT blocker_id FROM blocks WHERE blocked_id = ?", userID, userID).
+		Scan(&userIDs).Error
+	return userIDs, err
+}
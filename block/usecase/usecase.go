@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"github.com/jordyf15/tweeter-api/block"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/timeline"
+	"github.com/jordyf15/tweeter-api/user"
+)
+
+type blockUsecase struct {
+	blockRepo    block.Repository
+	userRepo     user.Repository
+	timelineRepo timeline.Repository
+}
+
+func NewBlockUsecase(blockRepo block.Repository, userRepo user.Repository, timelineRepo timeline.Repository) block.Usecase {
+	return &blockUsecase{blockRepo: blockRepo, userRepo: userRepo, timelineRepo: timelineRepo}
+}
+
+// Block makes the blocker block the user and removes the follows between them. The cached home
+// timelines of both users are dropped so they no longer hold each other's tweets.
+func (usecase *blockUsecase) Block(blockerID, blockedID string) error {
+	if blockerID == blockedID {
+		return custom_errors.ErrBlockSelf
+	}
+
+	err := usecase.verifyUserExist(blockedID)
+	if err != nil {
+		return err
+	}
+
+	isCreated, err := usecase.blockRepo.Create(&models.Block{BlockerID: blockerID, BlockedID: blockedID})
+	if err != nil {
+		return err
+	}
+
+	if !isCreated {
+		return custom_errors.ErrAlreadyBlocked
+	}
+
+	err = usecase.timelineRepo.DeleteHomeTimeline(blockerID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.timelineRepo.DeleteHomeTimeline(blockedID)
+}
+
+func (usecase *blockUsecase) Unblock(blockerID, blockedID string) error {
+	if blockerID == blockedID {
+		return custom_errors.ErrBlockSelf
+	}
+
+	err := usecase.verifyUserExist(blockedID)
+	if err != nil {
+		return err
+	}
+
+	isBlocked, err := usecase.blockRepo.IsExist(blockerID, blockedID)
+	if err != nil {
+		return err
+	}
+
+	if !isBlocked {
+		return custom_errors.ErrNotBlocked
+	}
+
+	return usecase.blockRepo.Delete(blockerID, blockedID)
+}
+
+func (usecase *blockUsecase) verifyUserExist(userID string) error {
+	isExist, err := usecase.userRepo.IsIDExist(userID)
+	if err != nil {
+		return err
+	}
+
+	if !isExist {
+		return custom_errors.ErrRecordNotFound
+	}
+
+	return nil
+}
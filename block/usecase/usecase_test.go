@@ -0,0 +1,99 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/jordyf15/tweeter-api/block"
+	blockMocks "github.com/jordyf15/tweeter-api/block/mocks"
+	"github.com/jordyf15/tweeter-api/block/usecase"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	timelineMocks "github.com/jordyf15/tweeter-api/timeline/mocks"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBlockUsecase(t *testing.T) {
+	suite.Run(t, new(blockUsecaseSuite))
+}
+
+type blockUsecaseSuite struct {
+	suite.Suite
+	usecase      block.Usecase
+	blockRepo    *blockMocks.Repository
+	userRepo     *userMocks.Repository
+	timelineRepo *timelineMocks.Repository
+}
+
+func (s *blockUsecaseSuite) SetupTest() {
+	s.blockRepo = new(blockMocks.Repository)
+	s.userRepo = new(userMocks.Repository)
+	s.timelineRepo = new(timelineMocks.Repository)
+
+	isIDExist := func(userID string) bool {
+		return userID != "userID3"
+	}
+
+	// userID1 has already blocked userID4
+	isExist := func(blockerID, blockedID string) bool {
+		return blockerID == "userID1" && blockedID == "userID4"
+	}
+
+	s.userRepo.On("IsIDExist", mock.AnythingOfType("string")).Return(isIDExist, nil)
+	s.blockRepo.On("IsExist", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isExist, nil)
+	s.blockRepo.On("Create", mock.AnythingOfType("*models.Block")).Return(func(block *models.Block) bool { return !isExist(block.BlockerID, block.BlockedID) }, nil)
+	s.blockRepo.On("Delete", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	s.timelineRepo.On("DeleteHomeTimeline", mock.AnythingOfType("string")).Return(nil)
+
+	s.usecase = usecase.NewBlockUsecase(s.blockRepo, s.userRepo, s.timelineRepo)
+}
+
+func (s *blockUsecaseSuite) TestBlockSelf() {
+	err := s.usecase.Block("userID1", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrBlockSelf.Error(), err.Error())
+	s.blockRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *blockUsecaseSuite) TestBlockUserNotFound() {
+	err := s.usecase.Block("userID1", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+	s.blockRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *blockUsecaseSuite) TestBlockAlreadyBlocked() {
+	err := s.usecase.Block("userID1", "userID4")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrAlreadyBlocked.Error(), err.Error())
+	s.timelineRepo.AssertNumberOfCalls(s.T(), "DeleteHomeTimeline", 0)
+}
+
+func (s *blockUsecaseSuite) TestBlockSuccessful() {
+	err := s.usecase.Block("userID1", "userID2")
+
+	assert.NoError(s.T(), err)
+	s.blockRepo.AssertCalled(s.T(), "Create", &models.Block{BlockerID: "userID1", BlockedID: "userID2"})
+	s.timelineRepo.AssertCalled(s.T(), "DeleteHomeTimeline", "userID1")
+	s.timelineRepo.AssertCalled(s.T(), "DeleteHomeTimeline", "userID2")
+}
+
+func (s *blockUsecaseSuite) TestUnblockNotBlocked() {
+	err := s.usecase.Unblock("userID1", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrNotBlocked.Error(), err.Error())
+	s.blockRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *blockUsecaseSuite) TestUnblockSuccessful() {
+	err := s.usecase.Unblock("userID1", "userID4")
+
+	assert.NoError(s.T(), err)
+	s.blockRepo.AssertCalled(s.T(), "Delete", "userID1", "userID4")
+}
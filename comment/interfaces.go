@@ -0,0 +1,29 @@
+package comment
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+var (
+	MaxImageCount = 4
+	ImageWidth    = uint(1200)
+	ImageHeight   = uint(675)
+)
+
+type Usecase interface {
+	Create(comment *models.Comment, images []utils.NamedFileReader) (*models.Comment, error)
+	GetTweetComments(viewerID, tweetID string, cursor *utils.Cursor, limit int, oldestFirst bool) ([]*models.Comment, *utils.Cursor, error)
+	Delete(tweetID, commentID, userID string) error
+	GetUserComments(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.Comment, *utils.Cursor, error)
+}
+
+type Repository interface {
+	Create(comment *models.Comment) error
+	CreateTransaction(fn func(repo Repository) error) error
+	GetByID(id string) (*models.Comment, error)
+	GetByTweetID(tweetID string, cursor *utils.Cursor, limit int, oldestFirst bool) ([]*models.Comment, error)
+	GetByUserID(userID string, cursor *utils.Cursor, limit int) ([]*models.Comment, error)
+	Delete(id string) error
+	SetHashtags(commentID string, names []string) error
+}
@@ -0,0 +1,157 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	comment "github.com/jordyf15/tweeter-api/comment"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jordyf15/tweeter-api/models"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0
+func (_m *Repository) Create(_a0 *models.Comment) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Comment) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTransaction provides a mock function with given fields: fn
+func (_m *Repository) CreateTransaction(fn func(comment.Repository) error) error {
+	ret := _m.Called(fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(comment.Repository) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *Repository) Delete(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *Repository) GetByID(id string) (*models.Comment, error) {
+	ret := _m.Called(id)
+
+	var r0 *models.Comment
+	if rf, ok := ret.Get(0).(func(string) *models.Comment); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByTweetID provides a mock function with given fields: tweetID, cursor, limit, oldestFirst
+func (_m *Repository) GetByTweetID(tweetID string, cursor *utils.Cursor, limit int, oldestFirst bool) ([]*models.Comment, error) {
+	ret := _m.Called(tweetID, cursor, limit, oldestFirst)
+
+	var r0 []*models.Comment
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int, bool) []*models.Comment); ok {
+		r0 = rf(tweetID, cursor, limit, oldestFirst)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int, bool) error); ok {
+		r1 = rf(tweetID, cursor, limit, oldestFirst)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUserID provides a mock function with given fields: userID, cursor, limit
+func (_m *Repository) GetByUserID(userID string, cursor *utils.Cursor, limit int) ([]*models.Comment, error) {
+	ret := _m.Called(userID, cursor, limit)
+
+	var r0 []*models.Comment
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.Comment); ok {
+		r0 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) error); ok {
+		r1 = rf(userID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetHashtags provides a mock function with given fields: commentID, names
+func (_m *Repository) SetHashtags(commentID string, names []string) error {
+	ret := _m.Called(commentID, names)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(commentID, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
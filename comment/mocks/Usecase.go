@@ -0,0 +1,131 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Usecase is an autogenerated mock type for the Usecase type
+type Usecase struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, images
+func (_m *Usecase) Create(_a0 *models.Comment, images []utils.NamedFileReader) (*models.Comment, error) {
+	ret := _m.Called(_a0, images)
+
+	var r0 *models.Comment
+	if rf, ok := ret.Get(0).(func(*models.Comment, []utils.NamedFileReader) *models.Comment); ok {
+		r0 = rf(_a0, images)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Comment, []utils.NamedFileReader) error); ok {
+		r1 = rf(_a0, images)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: tweetID, commentID, userID
+func (_m *Usecase) Delete(tweetID string, commentID string, userID string) error {
+	ret := _m.Called(tweetID, commentID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(tweetID, commentID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTweetComments provides a mock function with given fields: viewerID, tweetID, cursor, limit, oldestFirst
+func (_m *Usecase) GetTweetComments(viewerID string, tweetID string, cursor *utils.Cursor, limit int, oldestFirst bool) ([]*models.Comment, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, tweetID, cursor, limit, oldestFirst)
+
+	var r0 []*models.Comment
+	if rf, ok := ret.Get(0).(func(string, string, *utils.Cursor, int, bool) []*models.Comment); ok {
+		r0 = rf(viewerID, tweetID, cursor, limit, oldestFirst)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, *utils.Cursor, int, bool) *utils.Cursor); ok {
+		r1 = rf(viewerID, tweetID, cursor, limit, oldestFirst)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, *utils.Cursor, int, bool) error); ok {
+		r2 = rf(viewerID, tweetID, cursor, limit, oldestFirst)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetUserComments provides a mock function with given fields: viewerID, userID, cursor, limit
+func (_m *Usecase) GetUserComments(viewerID string, userID string, cursor *utils.Cursor, limit int) ([]*models.Comment, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, userID, cursor, limit)
+
+	var r0 []*models.Comment
+	if rf, ok := ret.Get(0).(func(string, string, *utils.Cursor, int) []*models.Comment); ok {
+		r0 = rf(viewerID, userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(viewerID, userID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, *utils.Cursor, int) error); ok {
+		r2 = rf(viewerID, userID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+type mockConstructorTestingTNewUsecase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUsecase creates a new instance of Usecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUsecase(t mockConstructorTestingTNewUsecase) *Usecase {
+	mock := &Usecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"github.com/jordyf15/tweeter-api/comment"
+	hr "github.com/jordyf15/tweeter-api/hashtag/repository"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+)
+
+type commentRepository struct {
+	DB *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) comment.Repository {
+	return &commentRepository{DB: db}
+}
+
+func (repo *commentRepository) Create(comment *models.Comment) error {
+	return repo.DB.Create(comment).Error
+}
+
+func (repo *commentRepository) CreateTransaction(fn func(repo comment.Repository) error) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(&commentRepository{DB: tx})
+	})
+}
+
+func (repo *commentRepository) GetByID(id string) (*models.Comment, error) {
+	comment := &models.Comment{}
+
+	err := repo.DB.Table("comments").Where("id = ?", id).First(comment).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (repo *commentRepository) GetByTweetID(tweetID string, cursor *utils.Cursor, limit int, oldestFirst bool) ([]*models.Comment, error) {
+	comments := make([]*models.Comment, 0)
+
+	query := repo.DB.Table("comments").Where("tweet_id = ?", tweetID)
+	if oldestFirst {
+		if cursor != nil {
+			query = query.Where("(created_at, id) > (?, ?)", cursor.CreatedAt, cursor.ID)
+		}
+		query = query.Order("created_at ASC, id ASC")
+	} else {
+		if cursor != nil {
+			query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+		}
+		query = query.Order("created_at DESC, id DESC")
+	}
+
+	err := query.Limit(limit).Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (repo *commentRepository) GetByUserID(userID string, cursor *utils.Cursor, limit int) ([]*models.Comment, error) {
+	comments := make([]*models.Comment, 0)
+
+	query := repo.DB.Table("comments").Where("user_id = ?", userID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (repo *commentRepository) Delete(id string) error {
+	return repo.DB.Where("id = ?", id).Delete(&models.Comment{}).Error
+}
+
+func (repo *commentRepository) SetHashtags(commentID string, names []string) error {
+	return hr.NewHashtagRepository(repo.DB).SyncReferences(commentID, models.TagResourceTypeComment, names)
+}
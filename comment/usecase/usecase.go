@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/jordyf15/tweeter-api/comment"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/user"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type commentUsecase struct {
+	commentRepo  comment.Repository
+	tweetRepo    tweet.Repository
+	tweetUsecase tweet.Usecase
+	userRepo     user.Repository
+	storage      storage.Storage
+}
+
+func NewCommentUsecase(commentRepo comment.Repository, tweetRepo tweet.Repository, tweetUsecase tweet.Usecase, userRepo user.Repository, storage storage.Storage) comment.Usecase {
+	return &commentUsecase{commentRepo: commentRepo, tweetRepo: tweetRepo, tweetUsecase: tweetUsecase, userRepo: userRepo, storage: storage}
+}
+
+func (usecase *commentUsecase) Create(_comment *models.Comment, imageReaders []utils.NamedFileReader) (*models.Comment, error) {
+	errors := make([]error, 0)
+
+	validateFieldErrors := _comment.VerifyFields()
+	if len(validateFieldErrors) > 0 {
+		errors = append(errors, validateFieldErrors...)
+	}
+
+	if len(imageReaders) > comment.MaxImageCount {
+		errors = append(errors, custom_errors.ErrCommentImageCountExceeded)
+	}
+
+	if !utils.IsImageFormatValid(imageReaders) {
+		errors = append(errors, custom_errors.ErrCommentImageInvalidFormat)
+	}
+
+	if len(errors) > 0 {
+		return nil, &custom_errors.MultipleErrors{Errors: errors}
+	}
+
+	err := usecase.tweetUsecase.VerifyReplyPermission(_comment.TweetID, _comment.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = usecase.commentRepo.CreateTransaction(func(repo comment.Repository) error {
+		_comment.ID = uuid.New().String()
+		_comment.Images = make([]*models.Image, len(imageReaders))
+
+		for i, imageReader := range imageReaders {
+			image := &models.Image{}
+			image.Filename = utils.RandFileName("", "."+utils.GetFileExtension(imageReader.Name()))
+			image.Width = comment.ImageWidth
+			image.Height = comment.ImageHeight
+
+			_comment.Images[i] = image
+		}
+
+		err := repo.Create(_comment)
+		if err != nil {
+			return err
+		}
+
+		err = repo.SetHashtags(_comment.ID, utils.ExtractHashtags(_comment.Comment))
+		if err != nil {
+			return err
+		}
+
+		return storage.UploadImages(usecase.storage, imageReaders, _comment.Images, _comment.ImagePath)
+	})
+
+	if err != nil {
+		switch actualErr := err.(type) {
+		case *custom_errors.MultipleErrors:
+			errors = append(errors, actualErr.Errors...)
+		default:
+			errors = append(errors, err)
+		}
+	}
+
+	if len(errors) > 0 {
+		return nil, &custom_errors.MultipleErrors{Errors: errors}
+	}
+
+	_comment.User, err = usecase.userRepo.GetByID(_comment.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.storage.AssignImageURLToUser(_comment.User)
+	usecase.storage.AssignImageURLToComment(_comment)
+
+	return _comment, nil
+}
+
+func (usecase *commentUsecase) GetTweetComments(viewerID, tweetID string, cursor *utils.Cursor, limit int, oldestFirst bool) ([]*models.Comment, *utils.Cursor, error) {
+	err := usecase.tweetUsecase.VerifyViewPermission(tweetID, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comments, err := usecase.commentRepo.GetByTweetID(tweetID, cursor, limit+1, oldestFirst)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(comments) > limit {
+		comments = comments[:limit]
+		lastComment := comments[limit-1]
+		nextCursor = utils.NewCursor(lastComment.CreatedAt, lastComment.ID)
+	}
+
+	err = usecase.assignUsers(comments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, _comment := range comments {
+		usecase.storage.AssignImageURLToComment(_comment)
+	}
+
+	return comments, nextCursor, nil
+}
+
+func (usecase *commentUsecase) Delete(tweetID, commentID, userID string) error {
+	_comment, err := usecase.commentRepo.GetByID(commentID)
+	if err != nil {
+		return err
+	}
+
+	if _comment.TweetID != tweetID {
+		return custom_errors.ErrRecordNotFound
+	}
+
+	if _comment.UserID != userID {
+		return custom_errors.ErrCommentDeleteForbidden
+	}
+
+	return usecase.commentRepo.CreateTransaction(func(repo comment.Repository) error {
+		err := repo.Delete(_comment.ID)
+		if err != nil {
+			return err
+		}
+
+		removeChannels := make(chan error, len(_comment.Images))
+		var wg sync.WaitGroup
+		wg.Add(len(_comment.Images))
+
+		for _, image := range _comment.Images {
+			go usecase.storage.RemoveFile(removeChannels, &wg, _comment.ImagePath(image))
+		}
+
+		wg.Wait()
+		close(removeChannels)
+
+		for err := range removeChannels {
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetUserComments returns the replies shown on the user's profile. Replies of users who have
+// a block with the viewer, or who are protected and not followed by the viewer, are not shown,
+// and neither are replies to tweets the viewer cannot see. The next cursor is taken before the
+// replies to hidden tweets are dropped, so a page can come back short while more pages remain.
+func (usecase *commentUsecase) GetUserComments(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.Comment, *utils.Cursor, error) {
+	err := usecase.tweetUsecase.VerifyUserViewPermission(userID, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comments, err := usecase.commentRepo.GetByUserID(userID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(comments) > limit {
+		comments = comments[:limit]
+		lastComment := comments[limit-1]
+		nextCursor = utils.NewCursor(lastComment.CreatedAt, lastComment.ID)
+	}
+
+	comments, err = usecase.filterViewableComments(viewerID, comments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = usecase.assignUsers(comments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, _comment := range comments {
+		usecase.storage.AssignImageURLToComment(_comment)
+	}
+
+	return comments, nextCursor, nil
+}
+
+// filterViewableComments drops the comments on tweets the viewer cannot see, keeping the order of the rest.
+func (usecase *commentUsecase) filterViewableComments(viewerID string, comments []*models.Comment) ([]*models.Comment, error) {
+	tweetIDs := make([]string, 0, len(comments))
+	for _, _comment := range comments {
+		tweetIDs = append(tweetIDs, _comment.TweetID)
+	}
+
+	tweets, err := usecase.tweetRepo.GetByIDs(tweetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tweets, err = usecase.tweetUsecase.FilterViewableTweets(viewerID, tweets)
+	if err != nil {
+		return nil, err
+	}
+
+	isViewable := make(map[string]bool, len(tweets))
+	for _, _tweet := range tweets {
+		isViewable[_tweet.ID] = true
+	}
+
+	viewableComments := make([]*models.Comment, 0, len(comments))
+	for _, _comment := range comments {
+		if isViewable[_comment.TweetID] {
+			viewableComments = append(viewableComments, _comment)
+		}
+	}
+
+	return viewableComments, nil
+}
+
+func (usecase *commentUsecase) assignUsers(comments []*models.Comment) error {
+	userIDs := make([]string, 0, len(comments))
+	for _, _comment := range comments {
+		userIDs = append(userIDs, _comment.UserID)
+	}
+
+	usersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, _comment := range comments {
+		_comment.User = usersByID[_comment.UserID]
+	}
+
+	return nil
+}
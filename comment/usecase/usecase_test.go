@@ -0,0 +1,324 @@
+package usecase_test
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/comment"
+	commentMocks "github.com/jordyf15/tweeter-api/comment/mocks"
+	"github.com/jordyf15/tweeter-api/comment/usecase"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	tweetMocks "github.com/jordyf15/tweeter-api/tweet/mocks"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCommentUsecase(t *testing.T) {
+	suite.Run(t, new(commentUsecaseSuite))
+}
+
+type commentUsecaseSuite struct {
+	suite.Suite
+	usecase      comment.Usecase
+	commentRepo  *commentMocks.Repository
+	tweetRepo    *tweetMocks.Repository
+	tweetUsecase *tweetMocks.Usecase
+	userRepo     *userMocks.Repository
+	storageMock  *storageMocks.Storage
+}
+
+var (
+	utUser = &models.User{
+		ID:       "userID1",
+		Username: "username",
+	}
+
+	utComments = []*models.Comment{
+		{ID: "commentID1", TweetID: "tweetID1", UserID: "userID1", Comment: "comment 1", CreatedAt: time.Now()},
+		{ID: "commentID2", TweetID: "tweetID1", UserID: "userID1", Comment: "comment 2", CreatedAt: time.Now().Add(-time.Minute)},
+		{ID: "commentID3", TweetID: "tweetID1", UserID: "userID1", Comment: "comment 3", CreatedAt: time.Now().Add(-time.Hour)},
+	}
+)
+
+func (s *commentUsecaseSuite) SetupTest() {
+	s.commentRepo = new(commentMocks.Repository)
+	s.tweetRepo = new(tweetMocks.Repository)
+	s.tweetUsecase = new(tweetMocks.Usecase)
+	s.userRepo = new(userMocks.Repository)
+	s.storageMock = new(storageMocks.Storage)
+
+	createTransaction := func(fn func(repo comment.Repository) error) error {
+		return fn(s.commentRepo)
+	}
+
+	verifyReplyPermission := func(tweetID, userID string) error {
+		if userID == "userID3" {
+			return custom_errors.ErrTweetReplyRestricted
+		}
+
+		return nil
+	}
+
+	// tweetID2 is by a protected user the viewers do not follow
+	verifyViewPermission := func(tweetID, viewerID string) error {
+		if tweetID == "tweetID2" {
+			return custom_errors.ErrTweetProtected
+		}
+
+		return nil
+	}
+
+	// userID2 does not exist, userID3 has blocked userID1 and userID4 is protected
+	verifyUserViewPermission := func(userID, viewerID string) error {
+		switch {
+		case userID == "userID2":
+			return custom_errors.ErrRecordNotFound
+		case userID == "userID1" && viewerID == "userID3":
+			return custom_errors.ErrUserBlocked
+		case userID == "userID4":
+			return custom_errors.ErrTweetProtected
+		}
+
+		return nil
+	}
+
+	// tweetID2 is posted by userID4
+	getTweetsByIDs := func(ids []string) []*models.Tweet {
+		tweets := make([]*models.Tweet, 0, len(ids))
+		for _, id := range ids {
+			if id == "tweetID2" {
+				tweets = append(tweets, &models.Tweet{ID: id, UserID: "userID4"})
+			} else {
+				tweets = append(tweets, &models.Tweet{ID: id, UserID: "userID1"})
+			}
+		}
+
+		return tweets
+	}
+
+	filterViewableTweets := func(viewerID string, tweets []*models.Tweet) []*models.Tweet {
+		viewableTweets := make([]*models.Tweet, 0, len(tweets))
+		for _, _tweet := range tweets {
+			if _tweet.UserID != "userID4" {
+				viewableTweets = append(viewableTweets, _tweet)
+			}
+		}
+
+		return viewableTweets
+	}
+
+	getCommentByID := func(commentID string) *models.Comment {
+		return &models.Comment{ID: commentID, TweetID: "tweetID1", UserID: "userID1", Comment: "comment", Images: models.Images{{Filename: "image.jpg"}}}
+	}
+
+	getCommentsByTweetID := func(tweetID string, cursor *utils.Cursor, limit int, oldestFirst bool) []*models.Comment {
+		if limit > len(utComments) {
+			return utComments
+		}
+
+		return utComments[:limit]
+	}
+
+	s.commentRepo.On("CreateTransaction", mock.Anything).Return(createTransaction)
+	s.commentRepo.On("Create", mock.AnythingOfType("*models.Comment")).Return(nil)
+	s.commentRepo.On("GetByID", mock.AnythingOfType("string")).Return(getCommentByID, nil)
+	s.commentRepo.On("GetByTweetID", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int"), mock.AnythingOfType("bool")).Return(getCommentsByTweetID, nil)
+	s.commentRepo.On("GetByUserID", "userID5", mock.Anything, mock.AnythingOfType("int")).Return([]*models.Comment{
+		{ID: "commentID4", TweetID: "tweetID2", UserID: "userID5", Comment: "comment 4", CreatedAt: time.Now()},
+		{ID: "commentID5", TweetID: "tweetID1", UserID: "userID5", Comment: "comment 5", CreatedAt: time.Now().Add(-time.Minute)},
+	}, nil)
+	s.commentRepo.On("GetByUserID", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(utComments, nil)
+	s.commentRepo.On("Delete", mock.AnythingOfType("string")).Return(nil)
+	s.commentRepo.On("SetHashtags", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return(nil)
+	s.tweetRepo.On("GetByID", mock.AnythingOfType("string")).Return(&models.Tweet{ID: "tweetID1"}, nil)
+	s.tweetRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getTweetsByIDs, nil)
+	s.tweetUsecase.On("VerifyReplyPermission", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(verifyReplyPermission)
+	s.tweetUsecase.On("VerifyViewPermission", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(verifyViewPermission)
+	s.tweetUsecase.On("VerifyUserViewPermission", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(verifyUserViewPermission)
+	s.tweetUsecase.On("FilterViewableTweets", mock.AnythingOfType("string"), mock.AnythingOfType("[]*models.Tweet")).Return(filterViewableTweets, nil)
+	s.userRepo.On("GetByID", mock.AnythingOfType("string")).Return(utUser, nil)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return([]*models.User{utUser}, nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
+	s.storageMock.On("AssignImageURLToComment", mock.AnythingOfType("*models.Comment"))
+	s.storageMock.On("UploadFile", mock.AnythingOfType("chan<- error"), mock.AnythingOfType("*sync.WaitGroup"), mock.AnythingOfType("*os.File"), mock.AnythingOfType("string"), mock.AnythingOfType("map[string]string")).Run(func(args mock.Arguments) {
+		arg1 := args[0].(chan<- error)
+		arg1 <- nil
+		arg2 := args[1].(*sync.WaitGroup)
+		arg2.Done()
+	})
+	s.storageMock.On("RemoveFile", mock.AnythingOfType("chan<- error"), mock.AnythingOfType("*sync.WaitGroup"), mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+		arg1 := args[0].(chan<- error)
+		arg1 <- nil
+		arg2 := args[1].(*sync.WaitGroup)
+		arg2.Done()
+	})
+
+	s.usecase = usecase.NewCommentUsecase(s.commentRepo, s.tweetRepo, s.tweetUsecase, s.userRepo, s.storageMock)
+}
+
+func (s *commentUsecaseSuite) TestCreateCommentTooShort() {
+	_comment := &models.Comment{TweetID: "tweetID1", UserID: "userID1"}
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrCommentTooShort}}
+	result, err := s.usecase.Create(_comment, nil)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *commentUsecaseSuite) TestCreateCommentImageInvalidFormat() {
+	_comment := &models.Comment{TweetID: "tweetID1", UserID: "userID1", Comment: "comment"}
+
+	imgFile, _ := os.Open("../../assets/images/test_pic.gif")
+	defer imgFile.Close()
+	imgFileReader := utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name())))
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrCommentImageInvalidFormat}}
+	result, err := s.usecase.Create(_comment, []utils.NamedFileReader{imgFileReader})
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *commentUsecaseSuite) TestCreateCommentReplyRestricted() {
+	_comment := &models.Comment{TweetID: "tweetID1", UserID: "userID3", Comment: "comment"}
+
+	result, err := s.usecase.Create(_comment, nil)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), custom_errors.ErrTweetReplyRestricted.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *commentUsecaseSuite) TestCreateCommentSuccessful() {
+	_comment := &models.Comment{TweetID: "tweetID1", UserID: "userID1", Comment: "comment"}
+
+	imgFile, _ := os.Open("../../assets/images/default-profile.png")
+	defer imgFile.Close()
+	imgFileReader := utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name())))
+
+	result, err := s.usecase.Create(_comment, []utils.NamedFileReader{imgFileReader})
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), result)
+	assert.NotEmpty(s.T(), result.ID)
+	assert.Len(s.T(), result.Images, 1)
+	assert.Equal(s.T(), utUser, result.User)
+	s.commentRepo.AssertNumberOfCalls(s.T(), "Create", 1)
+	s.commentRepo.AssertCalled(s.T(), "SetHashtags", result.ID, []string{})
+	s.storageMock.AssertNumberOfCalls(s.T(), "UploadFile", 1)
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToComment", 1)
+}
+
+func (s *commentUsecaseSuite) TestGetTweetCommentsWithNextPage() {
+	comments, nextCursor, err := s.usecase.GetTweetComments("userID2", "tweetID1", nil, 2, false)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), comments, 2)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), utComments[1].ID, nextCursor.ID)
+	assert.Equal(s.T(), utUser, comments[0].User)
+	s.userRepo.AssertNumberOfCalls(s.T(), "GetByIDs", 1)
+}
+
+func (s *commentUsecaseSuite) TestGetTweetCommentsLastPage() {
+	comments, nextCursor, err := s.usecase.GetTweetComments("userID2", "tweetID1", nil, 20, false)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), comments, 3)
+	assert.Nil(s.T(), nextCursor)
+}
+
+func (s *commentUsecaseSuite) TestGetTweetCommentsProtected() {
+	comments, _, err := s.usecase.GetTweetComments("userID2", "tweetID2", nil, 20, false)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), comments)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "GetByTweetID", 0)
+}
+
+func (s *commentUsecaseSuite) TestDeleteCommentNotAuthor() {
+	err := s.usecase.Delete("tweetID1", "commentID1", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrCommentDeleteForbidden.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *commentUsecaseSuite) TestDeleteCommentWrongTweet() {
+	err := s.usecase.Delete("tweetID2", "commentID1", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *commentUsecaseSuite) TestDeleteCommentSuccessful() {
+	err := s.usecase.Delete("tweetID1", "commentID1", "userID1")
+
+	assert.NoError(s.T(), err)
+	s.commentRepo.AssertNumberOfCalls(s.T(), "Delete", 1)
+	s.storageMock.AssertNumberOfCalls(s.T(), "RemoveFile", 1)
+}
+
+func (s *commentUsecaseSuite) TestGetUserCommentsUserNotFound() {
+	comments, _, err := s.usecase.GetUserComments("userID1", "userID2", nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), comments)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "GetByUserID", 0)
+}
+
+func (s *commentUsecaseSuite) TestGetUserCommentsBlocked() {
+	comments, _, err := s.usecase.GetUserComments("userID3", "userID1", nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), comments)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "GetByUserID", 0)
+}
+
+func (s *commentUsecaseSuite) TestGetUserCommentsProtected() {
+	comments, _, err := s.usecase.GetUserComments("userID1", "userID4", nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), comments)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+	s.commentRepo.AssertNumberOfCalls(s.T(), "GetByUserID", 0)
+}
+
+func (s *commentUsecaseSuite) TestGetUserCommentsWithNextPage() {
+	comments, nextCursor, err := s.usecase.GetUserComments("userID2", "userID1", nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), comments, 2)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), utComments[1].ID, nextCursor.ID)
+	assert.Equal(s.T(), utUser, comments[0].User)
+	s.commentRepo.AssertCalled(s.T(), "GetByUserID", "userID1", mock.Anything, 3)
+}
+
+func (s *commentUsecaseSuite) TestGetUserCommentsOnProtectedTweet() {
+	comments, nextCursor, err := s.usecase.GetUserComments("userID1", "userID5", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	assert.Len(s.T(), comments, 1)
+	assert.Equal(s.T(), "commentID5", comments[0].ID)
+	s.tweetUsecase.AssertCalled(s.T(), "FilterViewableTweets", "userID1", mock.AnythingOfType("[]*models.Tweet"))
+}
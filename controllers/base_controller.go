@@ -2,12 +2,19 @@ package controllers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/utils"
 	"gorm.io/gorm"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
 func respondBasedOnError(c *gin.Context, err error) {
 	statusCode := getStatusCodeForError(err)
 
@@ -43,6 +50,13 @@ func getStatusCodeForError(err error) int {
 		switch modelError {
 		case custom_errors.ErrMalformedRefreshToken, custom_errors.ErrInvalidRefreshToken:
 			return http.StatusForbidden
+		case custom_errors.ErrTweetDeleteForbidden, custom_errors.ErrTweetReplyRestricted,
+			custom_errors.ErrCommentDeleteForbidden:
+			return http.StatusForbidden
+		case custom_errors.ErrFollowBlocked, custom_errors.ErrUserBlocked, custom_errors.ErrTweetProtected:
+			return http.StatusForbidden
+		case custom_errors.ErrGroupPermissionDenied:
+			return http.StatusForbidden
 		default:
 			return http.StatusBadRequest
 		}
@@ -55,3 +69,35 @@ func getStatusCodeForError(err error) int {
 		return http.StatusInternalServerError
 	}
 }
+
+func getPaginationParams(c *gin.Context) (*utils.Cursor, int, error) {
+	limit := defaultPageSize
+	if perPage, err := strconv.Atoi(c.Query("per_page")); err == nil && perPage > 0 {
+		limit = perPage
+	}
+
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	encodedCursor := c.Query("cursor")
+	if len(encodedCursor) == 0 {
+		return nil, limit, nil
+	}
+
+	cursor, err := utils.DecodeCursor(encodedCursor)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cursor, limit, nil
+}
+
+func paginationMeta(nextCursor *utils.Cursor) map[string]interface{} {
+	meta := map[string]interface{}{"next_cursor": nil}
+	if nextCursor != nil {
+		meta["next_cursor"] = nextCursor.Encode()
+	}
+
+	return meta
+}
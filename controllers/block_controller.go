@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/block"
+)
+
+type BlocksController interface {
+	BlockUser(c *gin.Context)
+	UnblockUser(c *gin.Context)
+}
+
+type blocksController struct {
+	usecase block.Usecase
+}
+
+func NewBlocksController(usecase block.Usecase) BlocksController {
+	return &blocksController{usecase: usecase}
+}
+
+func (controller *blocksController) BlockUser(c *gin.Context) {
+	blockerID := c.MustGet("current_user_id").(string)
+	blockedID := c.Param("user_id")
+
+	err := controller.usecase.Block(blockerID, blockedID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *blocksController) UnblockUser(c *gin.Context) {
+	blockerID := c.MustGet("current_user_id").(string)
+	blockedID := c.Param("user_id")
+
+	err := controller.usecase.Unblock(blockerID, blockedID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,90 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	blockMocks "github.com/jordyf15/tweeter-api/block/mocks"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBlockController(t *testing.T) {
+	suite.Run(t, new(blockControllerSuite))
+}
+
+type blockControllerSuite struct {
+	suite.Suite
+	router       *gin.Engine
+	response     *httptest.ResponseRecorder
+	controller   controllers.BlocksController
+	context      *gin.Context
+	blockUsecase *blockMocks.Usecase
+}
+
+func (s *blockControllerSuite) SetupTest() {
+	s.blockUsecase = new(blockMocks.Usecase)
+
+	unblock := func(blockerID, blockedID string) error {
+		if blockedID == "unblockedUserID" {
+			return custom_errors.ErrNotBlocked
+		}
+
+		return nil
+	}
+
+	s.blockUsecase.On("Block", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	s.blockUsecase.On("Unblock", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(unblock)
+
+	s.controller = controllers.NewBlocksController(s.blockUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	s.router.POST("/users/:user_id/block", setCurrentUser, s.controller.BlockUser)
+	s.router.DELETE("/users/:user_id/block", setCurrentUser, s.controller.UnblockUser)
+}
+
+func (s *blockControllerSuite) TestBlockUserSuccessful() {
+	s.context.Request, _ = http.NewRequest("POST", "/users/blockedUserID/block", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.blockUsecase.AssertCalled(s.T(), "Block", "userID", "blockedUserID")
+}
+
+func (s *blockControllerSuite) TestUnblockUserNotBlocked() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("DELETE", "/users/unblockedUserID/block", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrNotBlocked.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrNotBlocked.Code), error1["code"])
+}
+
+func (s *blockControllerSuite) TestUnblockUserSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/users/blockedUserID/block", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.blockUsecase.AssertCalled(s.T(), "Unblock", "userID", "blockedUserID")
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/comment"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type CommentsController interface {
+	CreateComment(c *gin.Context)
+	GetComments(c *gin.Context)
+	DeleteComment(c *gin.Context)
+}
+
+type commentsController struct {
+	usecase comment.Usecase
+}
+
+func NewCommentsController(usecase comment.Usecase) CommentsController {
+	return &commentsController{usecase: usecase}
+}
+
+func (controller *commentsController) CreateComment(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+
+	_comment := &models.Comment{}
+	_comment.TweetID = c.Param("tweet_id")
+	_comment.UserID = userID
+	_comment.Comment = c.PostForm("comment")
+
+	imageFiles := make([]utils.NamedFileReader, 0)
+	if form, err := c.MultipartForm(); err == nil {
+		imageHeaders := form.File["images"]
+		if len(imageHeaders) > comment.MaxImageCount {
+			respondBasedOnError(c, custom_errors.ErrCommentImageCountExceeded)
+			return
+		}
+
+		for _, imageHeader := range imageHeaders {
+			if imageHeader.Size > pictureSizesInMb*5 {
+				respondBasedOnError(c, custom_errors.ErrCommentImageTooLarge)
+				return
+			}
+
+			file, err := imageHeader.Open()
+			if err != nil {
+				fmt.Println(err.Error())
+				continue
+			}
+			imageFiles = append(imageFiles, utils.NewNamedFileReader(file, imageHeader.Filename))
+		}
+	}
+
+	createdComment, err := controller.usecase.Create(_comment, imageFiles)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, createdComment)
+}
+
+func (controller *commentsController) GetComments(c *gin.Context) {
+	viewerID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	var oldestFirst bool
+	switch c.DefaultQuery("order", "newest") {
+	case "newest":
+		oldestFirst = false
+	case "oldest":
+		oldestFirst = true
+	default:
+		respondBasedOnError(c, custom_errors.ErrInvalidSortOrder)
+		return
+	}
+
+	comments, nextCursor, err := controller.usecase.GetTweetComments(viewerID, tweetID, cursor, limit, oldestFirst)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(comments, paginationMeta(nextCursor)))
+}
+
+func (controller *commentsController) DeleteComment(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+	commentID := c.Param("comment_id")
+
+	err := controller.usecase.Delete(tweetID, commentID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,144 @@
+package controllers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	commentMocks "github.com/jordyf15/tweeter-api/comment/mocks"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCommentController(t *testing.T) {
+	suite.Run(t, new(commentControllerSuite))
+}
+
+type commentControllerSuite struct {
+	suite.Suite
+	router         *gin.Engine
+	response       *httptest.ResponseRecorder
+	controller     controllers.CommentsController
+	context        *gin.Context
+	commentUsecase *commentMocks.Usecase
+}
+
+var (
+	cctComment = &models.Comment{
+		ID:        "commentID",
+		TweetID:   "tweetID",
+		UserID:    "userID",
+		User:      &models.User{ID: "userID", Username: "username"},
+		Comment:   "comment",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	cctNextCursor = utils.NewCursor(time.Now(), "commentID")
+)
+
+func (s *commentControllerSuite) SetupTest() {
+	s.commentUsecase = new(commentMocks.Usecase)
+
+	s.commentUsecase.On("Create", mock.AnythingOfType("*models.Comment"), mock.Anything).Return(cctComment, nil)
+	s.commentUsecase.On("GetTweetComments", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int"), mock.AnythingOfType("bool")).Return([]*models.Comment{cctComment}, cctNextCursor, nil)
+	s.commentUsecase.On("Delete", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+
+	s.controller = controllers.NewCommentsController(s.commentUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	s.router.POST("/tweets/:tweet_id/comments", setCurrentUser, s.controller.CreateComment)
+	s.router.GET("/tweets/:tweet_id/comments", setCurrentUser, s.controller.GetComments)
+	s.router.DELETE("/tweets/:tweet_id/comments/:comment_id", setCurrentUser, s.controller.DeleteComment)
+}
+
+func (s *commentControllerSuite) TestCreateCommentSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	commentField, _ := writer.CreateFormField("comment")
+	commentField.Write([]byte(cctComment.Comment))
+	writer.Close()
+
+	s.context.Request, _ = http.NewRequest("POST", "/tweets/tweetID/comments", buf)
+	s.context.Request.Header.Set("Content-Type", writer.FormDataContentType())
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), cctComment.ID, receivedResponse["id"])
+	assert.Equal(s.T(), cctComment.TweetID, receivedResponse["tweet_id"])
+	assert.Equal(s.T(), cctComment.Comment, receivedResponse["comment"])
+}
+
+func (s *commentControllerSuite) TestGetCommentsInvalidCursor() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/tweets/tweetID/comments?cursor=%25%25", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrInvalidCursor.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrInvalidCursor.Code), error1["code"])
+}
+
+func (s *commentControllerSuite) TestGetCommentsInvalidOrder() {
+	s.context.Request, _ = http.NewRequest("GET", "/tweets/tweetID/comments?order=random", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+	s.commentUsecase.AssertNumberOfCalls(s.T(), "GetTweetComments", 0)
+}
+
+func (s *commentControllerSuite) TestGetCommentsSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/tweets/tweetID/comments?order=oldest&per_page=1&cursor="+cctNextCursor.Encode(), nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), cctNextCursor.Encode(), meta["next_cursor"])
+
+	s.commentUsecase.AssertCalled(s.T(), "GetTweetComments", "userID", "tweetID", mock.AnythingOfType("*utils.Cursor"), 1, true)
+}
+
+func (s *commentControllerSuite) TestDeleteCommentSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/tweets/tweetID/comments/commentID", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+}
@@ -5,11 +5,19 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/jordyf15/tweeter-api/follow"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
 )
 
 type FollowsController interface {
 	FollowUser(c *gin.Context)
 	UnfollowUser(c *gin.Context)
+	GetFollowers(c *gin.Context)
+	GetFollowings(c *gin.Context)
+	GetFollowersYouKnow(c *gin.Context)
+	GetFollowRequests(c *gin.Context)
+	ApproveFollowRequest(c *gin.Context)
+	DenyFollowRequest(c *gin.Context)
 }
 
 type followsController struct {
@@ -24,20 +32,63 @@ func (controller *followsController) FollowUser(c *gin.Context) {
 	followerID := c.MustGet("current_user_id").(string)
 	followingID := c.Param("user_id")
 
-	err := controller.usecase.FollowUser(followerID, followingID)
+	relationship, err := controller.usecase.FollowUser(followerID, followingID)
 	if err != nil {
 		respondBasedOnError(c, err)
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	c.JSON(http.StatusOK, relationship)
 }
 
 func (controller *followsController) UnfollowUser(c *gin.Context) {
 	followerID := c.MustGet("current_user_id").(string)
 	followingID := c.Param("user_id")
 
-	err := controller.usecase.UnfollowUser(followerID, followingID)
+	relationship, err := controller.usecase.UnfollowUser(followerID, followingID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, relationship)
+}
+
+func (controller *followsController) GetFollowers(c *gin.Context) {
+	controller.respondWithUsers(c, controller.usecase.GetFollowers)
+}
+
+func (controller *followsController) GetFollowings(c *gin.Context) {
+	controller.respondWithUsers(c, controller.usecase.GetFollowings)
+}
+
+func (controller *followsController) GetFollowersYouKnow(c *gin.Context) {
+	controller.respondWithUsers(c, controller.usecase.GetFollowersYouKnow)
+}
+
+func (controller *followsController) GetFollowRequests(c *gin.Context) {
+	userID := c.Param("user_id")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	users, nextCursor, err := controller.usecase.GetFollowRequests(userID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(users, paginationMeta(nextCursor)))
+}
+
+func (controller *followsController) ApproveFollowRequest(c *gin.Context) {
+	userID := c.Param("user_id")
+	requesterID := c.Param("requester_id")
+
+	err := controller.usecase.ApproveFollowRequest(userID, requesterID)
 	if err != nil {
 		respondBasedOnError(c, err)
 		return
@@ -45,3 +96,35 @@ func (controller *followsController) UnfollowUser(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+func (controller *followsController) DenyFollowRequest(c *gin.Context) {
+	userID := c.Param("user_id")
+	requesterID := c.Param("requester_id")
+
+	err := controller.usecase.DenyFollowRequest(userID, requesterID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *followsController) respondWithUsers(c *gin.Context, getUsers func(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error)) {
+	viewerID := c.MustGet("current_user_id").(string)
+	userID := c.Param("user_id")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	users, nextCursor, err := getUsers(viewerID, userID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(users, paginationMeta(nextCursor)))
+}
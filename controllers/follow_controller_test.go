@@ -1,12 +1,17 @@
 package controllers_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -20,17 +25,33 @@ func TestFollowController(t *testing.T) {
 
 type followControllerSuite struct {
 	suite.Suite
-	router     *gin.Engine
-	response   *httptest.ResponseRecorder
-	controller controllers.FollowsController
-	context    *gin.Context
+	router        *gin.Engine
+	response      *httptest.ResponseRecorder
+	controller    controllers.FollowsController
+	context       *gin.Context
+	followUsecase *followMocks.Usecase
 }
 
+var (
+	fctProfiles = []*models.UserProfile{
+		{User: &models.User{ID: "userID2", Username: "username2"}, IsFollowing: true},
+	}
+	fctNextCursor = utils.NewCursor(time.Now(), "userID2")
+)
+
 func (s *followControllerSuite) SetupTest() {
 	followUsecase := new(followMocks.Usecase)
+	s.followUsecase = followUsecase
 
-	followUsecase.On("FollowUser", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
-	followUsecase.On("UnfollowUser", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	followUsecase.On("FollowUser", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(&models.Relationship{IsFollowing: true}, nil)
+	followUsecase.On("UnfollowUser", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(&models.Relationship{IsFollowing: false}, nil)
+	followUsecase.On("GetFollowers", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(fctProfiles, fctNextCursor, nil)
+	followUsecase.On("GetFollowings", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(fctProfiles, nil, nil)
+	followUsecase.On("GetFollowersYouKnow", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(fctProfiles, nil, nil)
+	followUsecase.On("GetFollowRequests", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(fctProfiles, nil, nil)
+	followUsecase.On("ApproveFollowRequest", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	followUsecase.On("DenyFollowRequest", "userID", "userID4").Return(custom_errors.ErrFollowRequestNotFound)
+	followUsecase.On("DenyFollowRequest", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
 	s.controller = controllers.NewFollowsController(followUsecase)
 	s.response = httptest.NewRecorder()
@@ -44,18 +65,125 @@ func (s *followControllerSuite) SetupTest() {
 		c.Set("current_user_id", "userID")
 		c.Next()
 	}, s.controller.UnfollowUser)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	s.router.GET("/users/:user_id/followers", setCurrentUser, s.controller.GetFollowers)
+	s.router.GET("/users/:user_id/followers/you-know", setCurrentUser, s.controller.GetFollowersYouKnow)
+	s.router.GET("/users/:user_id/following", setCurrentUser, s.controller.GetFollowings)
+	s.router.GET("/users/:user_id/follow-requests", setCurrentUser, s.controller.GetFollowRequests)
+	s.router.POST("/users/:user_id/follow-requests/:requester_id", setCurrentUser, s.controller.ApproveFollowRequest)
+	s.router.DELETE("/users/:user_id/follow-requests/:requester_id", setCurrentUser, s.controller.DenyFollowRequest)
 }
 
 func (s *followControllerSuite) TestFollowUserSuccessful() {
+	var receivedResponse map[string]interface{}
+
 	s.context.Request, _ = http.NewRequest("POST", "/users/userID/follow", nil)
 	s.router.ServeHTTP(s.response, s.context.Request)
 
-	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), true, receivedResponse["is_following"])
+	assert.Equal(s.T(), false, receivedResponse["follows_you"])
 }
 
 func (s *followControllerSuite) TestUnfollowUserSuccessful() {
+	var receivedResponse map[string]interface{}
+
 	s.context.Request, _ = http.NewRequest("DELETE", "/users/userID/follow", nil)
 	s.router.ServeHTTP(s.response, s.context.Request)
 
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), false, receivedResponse["is_following"])
+	assert.Equal(s.T(), false, receivedResponse["follows_you"])
+}
+
+func (s *followControllerSuite) TestGetFollowersSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID3/followers?per_page=1&cursor="+fctNextCursor.Encode(), nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	user1 := data[0].(map[string]interface{})
+	assert.Equal(s.T(), "userID2", user1["id"])
+	assert.Equal(s.T(), true, user1["is_following"])
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), fctNextCursor.Encode(), meta["next_cursor"])
+
+	s.followUsecase.AssertCalled(s.T(), "GetFollowers", "userID", "userID3", mock.AnythingOfType("*utils.Cursor"), 1)
+}
+
+func (s *followControllerSuite) TestGetFollowingsSuccessful() {
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID3/following", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+	s.followUsecase.AssertCalled(s.T(), "GetFollowings", "userID", "userID3", mock.Anything, 20)
+}
+
+func (s *followControllerSuite) TestGetFollowersYouKnowSuccessful() {
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID3/followers/you-know", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+	s.followUsecase.AssertCalled(s.T(), "GetFollowersYouKnow", "userID", "userID3", mock.Anything, 20)
+	s.followUsecase.AssertNumberOfCalls(s.T(), "GetFollowers", 0)
+}
+
+func (s *followControllerSuite) TestGetFollowRequestsSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID/follow-requests", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+	s.followUsecase.AssertCalled(s.T(), "GetFollowRequests", "userID", mock.Anything, 20)
+}
+
+func (s *followControllerSuite) TestApproveFollowRequestSuccessful() {
+	s.context.Request, _ = http.NewRequest("POST", "/users/userID/follow-requests/userID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.followUsecase.AssertCalled(s.T(), "ApproveFollowRequest", "userID", "userID2")
+}
+
+func (s *followControllerSuite) TestDenyFollowRequestNotFound() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/users/userID/follow-requests/userID4", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+}
+
+func (s *followControllerSuite) TestDenyFollowRequestSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/users/userID/follow-requests/userID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
 	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.followUsecase.AssertCalled(s.T(), "DenyFollowRequest", "userID", "userID2")
 }
@@ -13,6 +13,19 @@ import (
 
 type GroupsController interface {
 	CreateGroup(c *gin.Context)
+	GetGroup(c *gin.Context)
+	EditGroup(c *gin.Context)
+	GetGroups(c *gin.Context)
+	GetUserGroups(c *gin.Context)
+	JoinGroup(c *gin.Context)
+	LeaveGroup(c *gin.Context)
+	GetJoinRequests(c *gin.Context)
+	ApproveJoinRequest(c *gin.Context)
+	RejectJoinRequest(c *gin.Context)
+	InviteUser(c *gin.Context)
+	GetInvitations(c *gin.Context)
+	AcceptInvitation(c *gin.Context)
+	DeclineInvitation(c *gin.Context)
 }
 
 type groupsController struct {
@@ -62,3 +75,233 @@ func (controller *groupsController) CreateGroup(c *gin.Context) {
 
 	c.JSON(http.StatusOK, createdGroup)
 }
+
+func (controller *groupsController) GetGroup(c *gin.Context) {
+	groupID := c.Param("group_id")
+
+	_group, err := controller.usecase.Get(groupID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _group)
+}
+
+func (controller *groupsController) EditGroup(c *gin.Context) {
+	groupID := c.Param("group_id")
+
+	updates := map[string]string{}
+	if newName, isExist := c.GetPostForm("name"); isExist {
+		updates["name"] = newName
+	}
+
+	if newDescription, isExist := c.GetPostForm("description"); isExist {
+		updates["description"] = newDescription
+	}
+
+	if newIsOpen, isExist := c.GetPostForm("is_open"); isExist {
+		updates["is_open"] = newIsOpen
+	}
+
+	groupImageHeader, err := c.FormFile("image")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+
+	var groupImageFile utils.NamedFileReader
+	if groupImageHeader != nil {
+		if groupImageHeader.Size > pictureSizesInMb*5 {
+			respondBasedOnError(c, custom_errors.ErrGroupImageTooLarge)
+			return
+		}
+
+		file, err := groupImageHeader.Open()
+		if err != nil {
+			fmt.Println(err.Error())
+		}
+		groupImageFile = utils.NewNamedFileReader(file, groupImageHeader.Filename)
+	}
+
+	editedGroup, err := controller.usecase.Edit(groupID, updates, groupImageFile)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, editedGroup)
+}
+
+func (controller *groupsController) GetGroups(c *gin.Context) {
+	name := c.Query("name")
+	sort := models.GroupSort(c.DefaultQuery("sort", string(models.GroupSortCreatedAt)))
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	groups, nextCursor, err := controller.usecase.GetGroups(name, sort, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(groups, paginationMeta(nextCursor)))
+}
+
+func (controller *groupsController) GetUserGroups(c *gin.Context) {
+	userID := c.Param("user_id")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	groups, nextCursor, err := controller.usecase.GetUserGroups(userID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(groups, paginationMeta(nextCursor)))
+}
+
+func (controller *groupsController) JoinGroup(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	groupID := c.Param("group_id")
+
+	request, err := controller.usecase.JoinGroup(groupID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	if request != nil {
+		c.JSON(http.StatusAccepted, request)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *groupsController) LeaveGroup(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	groupID := c.Param("group_id")
+
+	err := controller.usecase.LeaveGroup(groupID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *groupsController) GetJoinRequests(c *gin.Context) {
+	groupID := c.Param("group_id")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	requests, nextCursor, err := controller.usecase.GetJoinRequests(groupID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(requests, paginationMeta(nextCursor)))
+}
+
+func (controller *groupsController) ApproveJoinRequest(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	groupID := c.Param("group_id")
+	requestID := c.Param("join_request_id")
+
+	err := controller.usecase.ApproveJoinRequest(groupID, requestID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *groupsController) RejectJoinRequest(c *gin.Context) {
+	groupID := c.Param("group_id")
+	requestID := c.Param("join_request_id")
+
+	err := controller.usecase.RejectJoinRequest(groupID, requestID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *groupsController) InviteUser(c *gin.Context) {
+	inviterID := c.MustGet("current_user_id").(string)
+	groupID := c.Param("group_id")
+	inviteeID := c.PostForm("invitee_id")
+	inviteeUsername := c.PostForm("invitee_username")
+
+	invitation, err := controller.usecase.InviteUser(groupID, inviterID, inviteeID, inviteeUsername)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitation)
+}
+
+func (controller *groupsController) GetInvitations(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	invitations, nextCursor, err := controller.usecase.GetInvitations(userID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(invitations, paginationMeta(nextCursor)))
+}
+
+func (controller *groupsController) AcceptInvitation(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	groupID := c.Param("group_id")
+	invitationID := c.Param("invitation_id")
+
+	err := controller.usecase.AcceptInvitation(groupID, invitationID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *groupsController) DeclineInvitation(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	groupID := c.Param("group_id")
+	invitationID := c.Param("invitation_id")
+
+	err := controller.usecase.DeclineInvitation(groupID, invitationID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
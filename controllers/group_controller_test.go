@@ -7,7 +7,9 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,10 +18,14 @@ import (
 	"github.com/jordyf15/tweeter-api/custom_errors"
 	"github.com/jordyf15/tweeter-api/group"
 	groupMocks "github.com/jordyf15/tweeter-api/group/mocks"
+	groupMemberMocks "github.com/jordyf15/tweeter-api/group_member/mocks"
+	"github.com/jordyf15/tweeter-api/middlewares"
 	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 func TestGroupController(t *testing.T) {
@@ -28,10 +34,12 @@ func TestGroupController(t *testing.T) {
 
 type groupControllerSuite struct {
 	suite.Suite
-	router     *gin.Engine
-	response   *httptest.ResponseRecorder
-	controller controllers.GroupsController
-	context    *gin.Context
+	router             *gin.Engine
+	response           *httptest.ResponseRecorder
+	controller         controllers.GroupsController
+	context            *gin.Context
+	groupUsecase       *groupMocks.Usecase
+	groupMemberUsecase *groupMemberMocks.Usecase
 }
 
 var (
@@ -58,21 +66,85 @@ var (
 		IsOpen:    true,
 		CreatedAt: time.Now(),
 	}
+	gctNextCursor  = utils.NewCursor(time.Now(), "groupID")
+	gctJoinRequest = &models.GroupJoinRequest{
+		ID:          "requestID",
+		GroupID:     "groupID2",
+		RequesterID: "userID",
+		Requester:   &models.User{ID: "userID", Username: "username"},
+		CreatedAt:   time.Now(),
+	}
+	gctInvitation = &models.GroupInvitation{
+		ID:        "invitationID",
+		GroupID:   "groupID",
+		Group:     ugtGroup,
+		InviterID: "userID",
+		Inviter:   &models.User{ID: "userID", Username: "username"},
+		InviteeID: "userID2",
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
 )
 
 func (s *groupControllerSuite) SetupTest() {
 	groupUsecase := new(groupMocks.Usecase)
+	s.groupUsecase = groupUsecase
+	s.groupMemberUsecase = new(groupMemberMocks.Usecase)
 
 	s.controller = controllers.NewGroupsController(groupUsecase)
 	s.response = httptest.NewRecorder()
 	s.context, s.router = gin.CreateTestContext(s.response)
 
 	groupUsecase.On("Create", mock.AnythingOfType("*models.Group"), mock.Anything).Return(ugtGroup, nil)
+	groupUsecase.On("Get", "groupID").Return(ugtGroup, nil)
+	groupUsecase.On("Edit", mock.AnythingOfType("string"), mock.AnythingOfType("map[string]string"), mock.Anything).Return(ugtGroup, nil)
+	groupUsecase.On("Get", mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	groupUsecase.On("GetGroups", mock.AnythingOfType("string"), models.GroupSort("name"), mock.Anything, mock.AnythingOfType("int")).Return(nil, nil, custom_errors.ErrInvalidGroupSort)
+	groupUsecase.On("GetGroups", mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupSort"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.Group{ugtGroup}, gctNextCursor, nil)
+	groupUsecase.On("JoinGroup", "groupID2", mock.AnythingOfType("string")).Return(gctJoinRequest, nil)
+	groupUsecase.On("JoinGroup", "groupID3", mock.AnythingOfType("string")).Return(nil, custom_errors.ErrAlreadyGroupMember)
+	groupUsecase.On("JoinGroup", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, nil)
+	groupUsecase.On("LeaveGroup", "groupID2", mock.AnythingOfType("string")).Return(custom_errors.ErrLastGroupAdmin)
+	groupUsecase.On("LeaveGroup", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	groupUsecase.On("GetJoinRequests", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.GroupJoinRequest{gctJoinRequest}, nil, nil)
+	groupUsecase.On("ApproveJoinRequest", mock.AnythingOfType("string"), "requestID2", mock.AnythingOfType("string")).Return(custom_errors.ErrGroupJoinRequestNotFound)
+	groupUsecase.On("ApproveJoinRequest", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	groupUsecase.On("RejectJoinRequest", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	groupUsecase.On("InviteUser", mock.AnythingOfType("string"), mock.AnythingOfType("string"), "", "").Return(nil, custom_errors.ErrGroupInviteeMissing)
+	groupUsecase.On("InviteUser", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(gctInvitation, nil)
+	groupUsecase.On("GetInvitations", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.GroupInvitation{gctInvitation}, nil, nil)
+	groupUsecase.On("AcceptInvitation", mock.AnythingOfType("string"), "invitationID2", mock.AnythingOfType("string")).Return(custom_errors.ErrGroupInvitationNotFound)
+	groupUsecase.On("AcceptInvitation", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	groupUsecase.On("DeclineInvitation", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	groupUsecase.On("GetUserGroups", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.Group{ugtGroup}, nil, nil)
+	s.groupMemberUsecase.On("VerifyPermission", "groupID2", mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupAction")).Return(custom_errors.ErrGroupPermissionDenied)
+	s.groupMemberUsecase.On("VerifyPermission", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupAction")).Return(nil)
 
 	s.router.POST("/groups", func(c *gin.Context) {
 		c.Set("current_user_id", "userID")
 		c.Next()
 	}, s.controller.CreateGroup)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	s.router.GET("/groups", setCurrentUser, s.controller.GetGroups)
+	s.router.GET("/groups/:group_id", setCurrentUser, s.controller.GetGroup)
+	groupMiddleware := middlewares.NewGroupMiddleware(s.groupMemberUsecase)
+
+	s.router.PATCH("/groups/:group_id", setCurrentUser, groupMiddleware.EnsureGroupPermission(models.GroupActionEditGroup), s.controller.EditGroup)
+	s.router.GET("/users/:user_id/groups", setCurrentUser, s.controller.GetUserGroups)
+	s.router.POST("/groups/:group_id/join", setCurrentUser, s.controller.JoinGroup)
+	s.router.DELETE("/groups/:group_id/members/me", setCurrentUser, s.controller.LeaveGroup)
+	s.router.GET("/groups/:group_id/join-requests", setCurrentUser, groupMiddleware.EnsureGroupPermission(models.GroupActionManageJoinRequests), s.controller.GetJoinRequests)
+	s.router.POST("/groups/:group_id/join-requests/:join_request_id", setCurrentUser, groupMiddleware.EnsureGroupPermission(models.GroupActionManageJoinRequests), s.controller.ApproveJoinRequest)
+	s.router.DELETE("/groups/:group_id/join-requests/:join_request_id", setCurrentUser, groupMiddleware.EnsureGroupPermission(models.GroupActionManageJoinRequests), s.controller.RejectJoinRequest)
+	s.router.POST("/groups/:group_id/invitations", setCurrentUser, groupMiddleware.EnsureGroupPermission(models.GroupActionInviteMember), s.controller.InviteUser)
+	s.router.POST("/groups/:group_id/invitations/:invitation_id", setCurrentUser, s.controller.AcceptInvitation)
+	s.router.DELETE("/groups/:group_id/invitations/:invitation_id", setCurrentUser, s.controller.DeclineInvitation)
+	s.router.GET("/users/:user_id/group-invitations", setCurrentUser, s.controller.GetInvitations)
 }
 
 func (s *groupControllerSuite) TestCreateGroupMissingImage() {
@@ -167,3 +239,259 @@ func (s *groupControllerSuite) TestCreateGroupSuccessful() {
 	assert.True(s.T(), isExist)
 	assert.Equal(s.T(), ugtGroup.Images[1].URL, url)
 }
+
+func (s *groupControllerSuite) TestGetGroupNotFound() {
+	s.context.Request, _ = http.NewRequest("GET", "/groups/groupID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.response.Code)
+}
+
+func (s *groupControllerSuite) TestGetGroupSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/groups/groupID", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), ugtGroup.ID, receivedResponse["id"])
+	assert.Equal(s.T(), ugtGroup.Creator.Username, receivedResponse["creator"])
+}
+
+func (s *groupControllerSuite) TestGetGroupsInvalidSort() {
+	s.context.Request, _ = http.NewRequest("GET", "/groups?sort=name", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+}
+
+func (s *groupControllerSuite) TestGetGroupsSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/groups?name=holo&sort=member_count&per_page=1", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), gctNextCursor.Encode(), meta["next_cursor"])
+
+	s.groupUsecase.AssertCalled(s.T(), "GetGroups", "holo", models.GroupSortMemberCount, mock.Anything, 1)
+}
+
+func (s *groupControllerSuite) TestGetGroupsDefaultSort() {
+	s.context.Request, _ = http.NewRequest("GET", "/groups", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+	s.groupUsecase.AssertCalled(s.T(), "GetGroups", "", models.GroupSortCreatedAt, mock.Anything, 20)
+}
+
+func (s *groupControllerSuite) TestGetUserGroupsSuccessful() {
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID2/groups", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+	s.groupUsecase.AssertCalled(s.T(), "GetUserGroups", "userID2", mock.Anything, 20)
+}
+
+func (s *groupControllerSuite) TestJoinGroupAlreadyMember() {
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID3/join", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+}
+
+func (s *groupControllerSuite) TestJoinClosedGroupRequested() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID2/join", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), gctJoinRequest.ID, receivedResponse["id"])
+}
+
+func (s *groupControllerSuite) TestJoinGroupSuccessful() {
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID/join", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.groupUsecase.AssertCalled(s.T(), "JoinGroup", "groupID", "userID")
+}
+
+func (s *groupControllerSuite) TestLeaveGroupLastAdmin() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/groups/groupID2/members/me", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+}
+
+func (s *groupControllerSuite) TestLeaveGroupSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/groups/groupID/members/me", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.groupUsecase.AssertCalled(s.T(), "LeaveGroup", "groupID", "userID")
+}
+
+func (s *groupControllerSuite) TestGetJoinRequestsForbidden() {
+	s.context.Request, _ = http.NewRequest("GET", "/groups/groupID2/join-requests", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.response.Code)
+	s.groupMemberUsecase.AssertCalled(s.T(), "VerifyPermission", "groupID2", "userID", models.GroupActionManageJoinRequests)
+	s.groupUsecase.AssertNumberOfCalls(s.T(), "GetJoinRequests", 0)
+}
+
+func (s *groupControllerSuite) TestGetJoinRequestsSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/groups/groupID/join-requests", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	request := data[0].(map[string]interface{})
+	requester := request["requester"].(map[string]interface{})
+	assert.Equal(s.T(), "username", requester["username"])
+	s.groupUsecase.AssertCalled(s.T(), "GetJoinRequests", "groupID", mock.Anything, 20)
+}
+
+func (s *groupControllerSuite) TestApproveJoinRequestNotFound() {
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID/join-requests/requestID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+}
+
+func (s *groupControllerSuite) TestApproveJoinRequestSuccessful() {
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID/join-requests/requestID", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.groupUsecase.AssertCalled(s.T(), "ApproveJoinRequest", "groupID", "requestID", "userID")
+}
+
+func (s *groupControllerSuite) TestRejectJoinRequestSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/groups/groupID/join-requests/requestID", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.groupUsecase.AssertCalled(s.T(), "RejectJoinRequest", "groupID", "requestID")
+}
+
+func (s *groupControllerSuite) TestInviteUserInviteeMissing() {
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID/invitations", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+}
+
+func (s *groupControllerSuite) TestInviteUserSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	form := url.Values{}
+	form.Set("invitee_username", "username2")
+
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID/invitations", strings.NewReader(form.Encode()))
+	s.context.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), gctInvitation.ID, receivedResponse["id"])
+	assert.NotNil(s.T(), receivedResponse["expires_at"])
+	s.groupUsecase.AssertCalled(s.T(), "InviteUser", "groupID", "userID", "", "username2")
+}
+
+func (s *groupControllerSuite) TestGetInvitationsSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID/group-invitations", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	invitation := data[0].(map[string]interface{})
+	_group := invitation["group"].(map[string]interface{})
+	assert.Equal(s.T(), ugtGroup.Name, _group["name"])
+	s.groupUsecase.AssertCalled(s.T(), "GetInvitations", "userID", mock.Anything, 20)
+}
+
+func (s *groupControllerSuite) TestAcceptInvitationNotFound() {
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID/invitations/invitationID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+}
+
+func (s *groupControllerSuite) TestAcceptInvitationSuccessful() {
+	s.context.Request, _ = http.NewRequest("POST", "/groups/groupID/invitations/invitationID", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.groupUsecase.AssertCalled(s.T(), "AcceptInvitation", "groupID", "invitationID", "userID")
+}
+
+func (s *groupControllerSuite) TestDeclineInvitationSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/groups/groupID/invitations/invitationID", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.groupUsecase.AssertCalled(s.T(), "DeclineInvitation", "groupID", "invitationID", "userID")
+}
+
+func (s *groupControllerSuite) TestEditGroupForbidden() {
+	s.context.Request, _ = http.NewRequest("PATCH", "/groups/groupID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.response.Code)
+	s.groupUsecase.AssertNumberOfCalls(s.T(), "Edit", 0)
+}
+
+func (s *groupControllerSuite) TestEditGroupSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	form := url.Values{}
+	form.Set("name", "hololive jp")
+	form.Set("is_open", "false")
+
+	s.context.Request, _ = http.NewRequest("PATCH", "/groups/groupID", strings.NewReader(form.Encode()))
+	s.context.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), ugtGroup.ID, receivedResponse["id"])
+	s.groupUsecase.AssertCalled(s.T(), "Edit", "groupID", map[string]string{"name": "hololive jp", "is_open": "false"}, nil)
+}
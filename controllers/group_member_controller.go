@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/group_member"
+	"github.com/jordyf15/tweeter-api/models"
+)
+
+type GroupMembersController interface {
+	UpdateMemberRole(c *gin.Context)
+	RemoveMember(c *gin.Context)
+}
+
+type groupMembersController struct {
+	usecase group_member.Usecase
+}
+
+func NewGroupMembersController(usecase group_member.Usecase) GroupMembersController {
+	return &groupMembersController{usecase: usecase}
+}
+
+func (controller *groupMembersController) UpdateMemberRole(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	groupID := c.Param("group_id")
+	memberID := c.Param("member_id")
+	role := models.GroupMemberRole(c.PostForm("role"))
+
+	groupMember, err := controller.usecase.UpdateRole(groupID, memberID, userID, role)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, groupMember)
+}
+
+func (controller *groupMembersController) RemoveMember(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	groupID := c.Param("group_id")
+	memberID := c.Param("member_id")
+
+	err := controller.usecase.Remove(groupID, memberID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,131 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	groupMemberMocks "github.com/jordyf15/tweeter-api/group_member/mocks"
+	"github.com/jordyf15/tweeter-api/middlewares"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestGroupMemberController(t *testing.T) {
+	suite.Run(t, new(groupMemberControllerSuite))
+}
+
+type groupMemberControllerSuite struct {
+	suite.Suite
+	router             *gin.Engine
+	response           *httptest.ResponseRecorder
+	controller         controllers.GroupMembersController
+	context            *gin.Context
+	groupMemberUsecase *groupMemberMocks.Usecase
+}
+
+var gmctGroupMember = &models.GroupMember{
+	GroupID:  "groupID",
+	MemberID: "userID2",
+	Member:   &models.User{ID: "userID2", Username: "username2"},
+	Role:     models.GroupMemberRoleModerator,
+}
+
+func (s *groupMemberControllerSuite) SetupTest() {
+	s.groupMemberUsecase = new(groupMemberMocks.Usecase)
+
+	s.groupMemberUsecase.On("VerifyPermission", "groupID2", mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupAction")).Return(custom_errors.ErrGroupPermissionDenied)
+	s.groupMemberUsecase.On("VerifyPermission", "groupID3", mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupAction")).Return(gorm.ErrRecordNotFound)
+	s.groupMemberUsecase.On("VerifyPermission", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupAction")).Return(nil)
+	s.groupMemberUsecase.On("UpdateRole", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), models.GroupMemberRole("")).Return(nil, custom_errors.ErrInvalidGroupMemberRole)
+	s.groupMemberUsecase.On("UpdateRole", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupMemberRole")).Return(gmctGroupMember, nil)
+	s.groupMemberUsecase.On("Remove", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+
+	s.controller = controllers.NewGroupMembersController(s.groupMemberUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	groupMiddleware := middlewares.NewGroupMiddleware(s.groupMemberUsecase)
+
+	s.router.PATCH("/groups/:group_id/members/:member_id", setCurrentUser, groupMiddleware.EnsureGroupPermission(models.GroupActionChangeMemberRole), s.controller.UpdateMemberRole)
+	s.router.DELETE("/groups/:group_id/members/:member_id", setCurrentUser, groupMiddleware.EnsureGroupPermission(models.GroupActionRemoveMember), s.controller.RemoveMember)
+}
+
+func (s *groupMemberControllerSuite) TestUpdateMemberRoleForbidden() {
+	form := url.Values{}
+	form.Set("role", "moderator")
+
+	s.context.Request, _ = http.NewRequest("PATCH", "/groups/groupID2/members/userID2", strings.NewReader(form.Encode()))
+	s.context.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.response.Code)
+	s.groupMemberUsecase.AssertCalled(s.T(), "VerifyPermission", "groupID2", "userID", models.GroupActionChangeMemberRole)
+	s.groupMemberUsecase.AssertNumberOfCalls(s.T(), "UpdateRole", 0)
+}
+
+func (s *groupMemberControllerSuite) TestUpdateMemberRoleGroupNotFound() {
+	s.context.Request, _ = http.NewRequest("PATCH", "/groups/groupID3/members/userID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.response.Code)
+	s.groupMemberUsecase.AssertNumberOfCalls(s.T(), "UpdateRole", 0)
+}
+
+func (s *groupMemberControllerSuite) TestUpdateMemberRoleInvalidRole() {
+	s.context.Request, _ = http.NewRequest("PATCH", "/groups/groupID/members/userID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+}
+
+func (s *groupMemberControllerSuite) TestUpdateMemberRoleSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	form := url.Values{}
+	form.Set("role", "moderator")
+
+	s.context.Request, _ = http.NewRequest("PATCH", "/groups/groupID/members/userID2", strings.NewReader(form.Encode()))
+	s.context.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), "moderator", receivedResponse["role"])
+	member := receivedResponse["member"].(map[string]interface{})
+	assert.Equal(s.T(), "username2", member["username"])
+	s.groupMemberUsecase.AssertCalled(s.T(), "UpdateRole", "groupID", "userID2", "userID", models.GroupMemberRoleModerator)
+}
+
+func (s *groupMemberControllerSuite) TestRemoveMemberForbidden() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/groups/groupID2/members/userID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.response.Code)
+	s.groupMemberUsecase.AssertCalled(s.T(), "VerifyPermission", "groupID2", "userID", models.GroupActionRemoveMember)
+	s.groupMemberUsecase.AssertNumberOfCalls(s.T(), "Remove", 0)
+}
+
+func (s *groupMemberControllerSuite) TestRemoveMemberSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/groups/groupID/members/userID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.groupMemberUsecase.AssertCalled(s.T(), "Remove", "groupID", "userID2", "userID")
+}
@@ -0,0 +1,40 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/hashtag"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type HashtagsController interface {
+	GetHashtagTweets(c *gin.Context)
+}
+
+type hashtagsController struct {
+	usecase hashtag.Usecase
+}
+
+func NewHashtagsController(usecase hashtag.Usecase) HashtagsController {
+	return &hashtagsController{usecase: usecase}
+}
+
+func (controller *hashtagsController) GetHashtagTweets(c *gin.Context) {
+	viewerID := c.MustGet("current_user_id").(string)
+	name := c.Param("name")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	tweets, nextCursor, err := controller.usecase.GetTweets(viewerID, name, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(tweets, paginationMeta(nextCursor)))
+}
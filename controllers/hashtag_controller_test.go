@@ -0,0 +1,87 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	hashtagMocks "github.com/jordyf15/tweeter-api/hashtag/mocks"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestHashtagController(t *testing.T) {
+	suite.Run(t, new(hashtagControllerSuite))
+}
+
+type hashtagControllerSuite struct {
+	suite.Suite
+	router         *gin.Engine
+	response       *httptest.ResponseRecorder
+	controller     controllers.HashtagsController
+	context        *gin.Context
+	hashtagUsecase *hashtagMocks.Usecase
+}
+
+var (
+	hctTweet = &models.Tweet{
+		ID:          "tweetID",
+		User:        &models.User{ID: "userID", Username: "username"},
+		Description: "#golang",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	hctNextCursor = utils.NewCursor(time.Now(), "tweetID")
+)
+
+func (s *hashtagControllerSuite) SetupTest() {
+	s.hashtagUsecase = new(hashtagMocks.Usecase)
+
+	s.hashtagUsecase.On("GetTweets", "userID", "golang", mock.Anything, mock.AnythingOfType("int")).Return([]*models.Tweet{hctTweet}, hctNextCursor, nil)
+	s.hashtagUsecase.On("GetTweets", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(nil, nil, gorm.ErrRecordNotFound)
+
+	s.controller = controllers.NewHashtagsController(s.hashtagUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	s.router.GET("/hashtags/:name/tweets", func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}, s.controller.GetHashtagTweets)
+}
+
+func (s *hashtagControllerSuite) TestGetHashtagTweetsNotFound() {
+	s.context.Request, _ = http.NewRequest("GET", "/hashtags/rust/tweets", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.response.Code)
+}
+
+func (s *hashtagControllerSuite) TestGetHashtagTweetsSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/hashtags/golang/tweets?per_page=1", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), hctNextCursor.Encode(), meta["next_cursor"])
+
+	s.hashtagUsecase.AssertCalled(s.T(), "GetTweets", "userID", "golang", mock.Anything, 1)
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/like"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type LikesController interface {
+	LikeTweet(c *gin.Context)
+	UnlikeTweet(c *gin.Context)
+	GetTweetLikers(c *gin.Context)
+	LikeComment(c *gin.Context)
+	UnlikeComment(c *gin.Context)
+	GetCommentLikers(c *gin.Context)
+}
+
+type likesController struct {
+	usecase like.Usecase
+}
+
+func NewLikesController(usecase like.Usecase) LikesController {
+	return &likesController{usecase: usecase}
+}
+
+func (controller *likesController) LikeTweet(c *gin.Context) {
+	controller.like(c, c.Param("tweet_id"), models.LikeResourceTypeTweet)
+}
+
+func (controller *likesController) UnlikeTweet(c *gin.Context) {
+	controller.unlike(c, c.Param("tweet_id"), models.LikeResourceTypeTweet)
+}
+
+func (controller *likesController) GetTweetLikers(c *gin.Context) {
+	controller.getLikers(c, c.Param("tweet_id"), models.LikeResourceTypeTweet)
+}
+
+func (controller *likesController) LikeComment(c *gin.Context) {
+	controller.like(c, c.Param("comment_id"), models.LikeResourceTypeComment)
+}
+
+func (controller *likesController) UnlikeComment(c *gin.Context) {
+	controller.unlike(c, c.Param("comment_id"), models.LikeResourceTypeComment)
+}
+
+func (controller *likesController) GetCommentLikers(c *gin.Context) {
+	controller.getLikers(c, c.Param("comment_id"), models.LikeResourceTypeComment)
+}
+
+func (controller *likesController) like(c *gin.Context, resourceID string, resourceType models.LikeResourceType) {
+	userID := c.MustGet("current_user_id").(string)
+
+	err := controller.usecase.Like(userID, resourceID, resourceType)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *likesController) unlike(c *gin.Context, resourceID string, resourceType models.LikeResourceType) {
+	userID := c.MustGet("current_user_id").(string)
+
+	err := controller.usecase.Unlike(userID, resourceID, resourceType)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *likesController) getLikers(c *gin.Context, resourceID string, resourceType models.LikeResourceType) {
+	viewerID := c.MustGet("current_user_id").(string)
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	users, nextCursor, err := controller.usecase.GetLikers(viewerID, resourceID, resourceType, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(users, paginationMeta(nextCursor)))
+}
@@ -0,0 +1,124 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	likeMocks "github.com/jordyf15/tweeter-api/like/mocks"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLikeController(t *testing.T) {
+	suite.Run(t, new(likeControllerSuite))
+}
+
+type likeControllerSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	response    *httptest.ResponseRecorder
+	controller  controllers.LikesController
+	context     *gin.Context
+	likeUsecase *likeMocks.Usecase
+}
+
+var (
+	lctUser       = &models.User{ID: "userID2", Username: "username2"}
+	lctNextCursor = utils.NewCursor(time.Now(), "userID2")
+)
+
+func (s *likeControllerSuite) SetupTest() {
+	s.likeUsecase = new(likeMocks.Usecase)
+
+	like := func(userID, resourceID string, resourceType models.LikeResourceType) error {
+		if resourceID == "likedID" {
+			return custom_errors.ErrAlreadyLiked
+		}
+
+		return nil
+	}
+
+	s.likeUsecase.On("Like", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.LikeResourceType")).Return(like)
+	s.likeUsecase.On("Unlike", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.LikeResourceType")).Return(nil)
+	s.likeUsecase.On("GetLikers", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.LikeResourceType"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.User{lctUser}, lctNextCursor, nil)
+
+	s.controller = controllers.NewLikesController(s.likeUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	s.router.POST("/tweets/:tweet_id/like", setCurrentUser, s.controller.LikeTweet)
+	s.router.DELETE("/tweets/:tweet_id/like", setCurrentUser, s.controller.UnlikeTweet)
+	s.router.GET("/tweets/:tweet_id/likes", setCurrentUser, s.controller.GetTweetLikers)
+	s.router.POST("/comments/:comment_id/like", setCurrentUser, s.controller.LikeComment)
+	s.router.DELETE("/comments/:comment_id/like", setCurrentUser, s.controller.UnlikeComment)
+	s.router.GET("/comments/:comment_id/likes", setCurrentUser, s.controller.GetCommentLikers)
+}
+
+func (s *likeControllerSuite) TestLikeTweetAlreadyLiked() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("POST", "/tweets/likedID/like", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrAlreadyLiked.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrAlreadyLiked.Code), error1["code"])
+}
+
+func (s *likeControllerSuite) TestLikeTweetSuccessful() {
+	s.context.Request, _ = http.NewRequest("POST", "/tweets/tweetID/like", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.likeUsecase.AssertCalled(s.T(), "Like", "userID", "tweetID", models.LikeResourceTypeTweet)
+}
+
+func (s *likeControllerSuite) TestUnlikeCommentSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/comments/commentID/like", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.likeUsecase.AssertCalled(s.T(), "Unlike", "userID", "commentID", models.LikeResourceTypeComment)
+}
+
+func (s *likeControllerSuite) TestGetCommentLikersSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/comments/commentID/likes?per_page=1", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), lctNextCursor.Encode(), meta["next_cursor"])
+
+	s.likeUsecase.AssertCalled(s.T(), "GetLikers", "userID", "commentID", models.LikeResourceTypeComment, mock.Anything, 1)
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/mute"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type MutesController interface {
+	GetMutes(c *gin.Context)
+	CreateMute(c *gin.Context)
+	DeleteMute(c *gin.Context)
+}
+
+type mutesController struct {
+	usecase mute.Usecase
+}
+
+func NewMutesController(usecase mute.Usecase) MutesController {
+	return &mutesController{usecase: usecase}
+}
+
+func (controller *mutesController) GetMutes(c *gin.Context) {
+	userID := c.Param("user_id")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	mutes, nextCursor, err := controller.usecase.GetMutes(userID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(mutes, paginationMeta(nextCursor)))
+}
+
+func (controller *mutesController) CreateMute(c *gin.Context) {
+	_mute := newMute(c.Param("user_id"), c.GetPostForm)
+	duration := models.MuteDuration(c.PostForm("duration"))
+
+	createdMute, err := controller.usecase.Mute(_mute, duration)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, createdMute)
+}
+
+func (controller *mutesController) DeleteMute(c *gin.Context) {
+	// DELETE request bodies are not parsed as forms, so the target is read from the query string
+	_mute := newMute(c.Param("user_id"), c.GetQuery)
+
+	err := controller.usecase.Unmute(_mute)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// newMute builds the user's mute of the user or keyword read with getValue.
+func newMute(userID string, getValue func(key string) (string, bool)) *models.Mute {
+	_mute := &models.Mute{UserID: userID}
+
+	if mutedUserID, isExist := getValue("muted_user_id"); isExist {
+		_mute.MutedUserID = &mutedUserID
+	}
+
+	if keyword, isExist := getValue("keyword"); isExist {
+		_mute.Keyword = &keyword
+	}
+
+	return _mute
+}
@@ -0,0 +1,141 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	muteMocks "github.com/jordyf15/tweeter-api/mute/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMuteController(t *testing.T) {
+	suite.Run(t, new(muteControllerSuite))
+}
+
+type muteControllerSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	response    *httptest.ResponseRecorder
+	controller  controllers.MutesController
+	context     *gin.Context
+	muteUsecase *muteMocks.Usecase
+}
+
+var (
+	mctKeyword = "spoiler"
+	mctMute    = &models.Mute{
+		ID:        "muteID",
+		UserID:    "userID",
+		Keyword:   &mctKeyword,
+		CreatedAt: time.Now(),
+	}
+	mctNextCursor = utils.NewCursor(time.Now(), "muteID")
+)
+
+func (s *muteControllerSuite) SetupTest() {
+	s.muteUsecase = new(muteMocks.Usecase)
+
+	unmute := func(_mute *models.Mute) error {
+		if _mute.MutedUserID == nil && _mute.Keyword == nil {
+			return &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrMuteTargetInvalid}}
+		}
+
+		return nil
+	}
+
+	s.muteUsecase.On("GetMutes", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.Mute{mctMute}, mctNextCursor, nil)
+	s.muteUsecase.On("Mute", mock.AnythingOfType("*models.Mute"), mock.AnythingOfType("models.MuteDuration")).Return(mctMute, nil)
+	s.muteUsecase.On("Unmute", mock.AnythingOfType("*models.Mute")).Return(unmute)
+
+	s.controller = controllers.NewMutesController(s.muteUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	s.router.GET("/users/:user_id/mutes", s.controller.GetMutes)
+	s.router.POST("/users/:user_id/mutes", s.controller.CreateMute)
+	s.router.DELETE("/users/:user_id/mutes", s.controller.DeleteMute)
+}
+
+func (s *muteControllerSuite) TestGetMutesSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID/mutes?per_page=1", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	mute1 := data[0].(map[string]interface{})
+	assert.Equal(s.T(), mctKeyword, mute1["keyword"])
+	assert.Nil(s.T(), mute1["expires_at"])
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), mctNextCursor.Encode(), meta["next_cursor"])
+
+	s.muteUsecase.AssertCalled(s.T(), "GetMutes", "userID", mock.Anything, 1)
+}
+
+func (s *muteControllerSuite) TestCreateMuteSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	form := url.Values{}
+	form.Set("keyword", "spoiler")
+	form.Set("duration", "7d")
+
+	s.context.Request, _ = http.NewRequest("POST", "/users/userID/mutes", strings.NewReader(form.Encode()))
+	s.context.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+	assert.Equal(s.T(), mctMute.ID, receivedResponse["id"])
+
+	s.muteUsecase.AssertCalled(s.T(), "Mute", &models.Mute{UserID: "userID", Keyword: &mctKeyword}, models.MuteDurationWeek)
+}
+
+func (s *muteControllerSuite) TestDeleteMuteMissingTarget() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("DELETE", "/users/userID/mutes", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrMuteTargetInvalid.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrMuteTargetInvalid.Code), error1["code"])
+}
+
+func (s *muteControllerSuite) TestDeleteMuteSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/users/userID/mutes?muted_user_id=userID2", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+
+	mutedUserID := "userID2"
+	s.muteUsecase.AssertCalled(s.T(), "Unmute", &models.Mute{UserID: "userID", MutedUserID: &mutedUserID})
+}
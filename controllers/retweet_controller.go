@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/retweet"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type RetweetsController interface {
+	Retweet(c *gin.Context)
+	Unretweet(c *gin.Context)
+	GetRetweeters(c *gin.Context)
+}
+
+type retweetsController struct {
+	usecase retweet.Usecase
+}
+
+func NewRetweetsController(usecase retweet.Usecase) RetweetsController {
+	return &retweetsController{usecase: usecase}
+}
+
+func (controller *retweetsController) Retweet(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+
+	err := controller.usecase.Retweet(userID, tweetID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *retweetsController) Unretweet(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+
+	err := controller.usecase.Unretweet(userID, tweetID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *retweetsController) GetRetweeters(c *gin.Context) {
+	viewerID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	users, nextCursor, err := controller.usecase.GetRetweeters(viewerID, tweetID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(users, paginationMeta(nextCursor)))
+}
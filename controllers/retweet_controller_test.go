@@ -0,0 +1,114 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	retweetMocks "github.com/jordyf15/tweeter-api/retweet/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRetweetController(t *testing.T) {
+	suite.Run(t, new(retweetControllerSuite))
+}
+
+type retweetControllerSuite struct {
+	suite.Suite
+	router         *gin.Engine
+	response       *httptest.ResponseRecorder
+	controller     controllers.RetweetsController
+	context        *gin.Context
+	retweetUsecase *retweetMocks.Usecase
+}
+
+var rtctUser = &models.User{ID: "userID2", Username: "username2"}
+
+func (s *retweetControllerSuite) SetupTest() {
+	s.retweetUsecase = new(retweetMocks.Usecase)
+
+	retweet := func(userID, tweetID string) error {
+		if tweetID == "deletedTweetID" {
+			return custom_errors.ErrRetweetTweetNotFound
+		}
+
+		return nil
+	}
+
+	s.retweetUsecase.On("Retweet", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(retweet)
+	s.retweetUsecase.On("Unretweet", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	s.retweetUsecase.On("GetRetweeters", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.User{rtctUser}, nil, nil)
+
+	s.controller = controllers.NewRetweetsController(s.retweetUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	s.router.POST("/tweets/:tweet_id/retweet", setCurrentUser, s.controller.Retweet)
+	s.router.DELETE("/tweets/:tweet_id/retweet", setCurrentUser, s.controller.Unretweet)
+	s.router.GET("/tweets/:tweet_id/retweets", setCurrentUser, s.controller.GetRetweeters)
+}
+
+func (s *retweetControllerSuite) TestRetweetDeletedTweet() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("POST", "/tweets/deletedTweetID/retweet", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrRetweetTweetNotFound.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrRetweetTweetNotFound.Code), error1["code"])
+}
+
+func (s *retweetControllerSuite) TestRetweetSuccessful() {
+	s.context.Request, _ = http.NewRequest("POST", "/tweets/tweetID/retweet", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.retweetUsecase.AssertCalled(s.T(), "Retweet", "userID", "tweetID")
+}
+
+func (s *retweetControllerSuite) TestUnretweetSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/tweets/tweetID/retweet", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.retweetUsecase.AssertCalled(s.T(), "Unretweet", "userID", "tweetID")
+}
+
+func (s *retweetControllerSuite) TestGetRetweetersSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/tweets/tweetID/retweets", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Nil(s.T(), meta["next_cursor"])
+}
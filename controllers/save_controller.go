@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/save"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type SavesController interface {
+	SaveTweet(c *gin.Context)
+	UnsaveTweet(c *gin.Context)
+	GetSavedTweets(c *gin.Context)
+}
+
+type savesController struct {
+	usecase save.Usecase
+}
+
+func NewSavesController(usecase save.Usecase) SavesController {
+	return &savesController{usecase: usecase}
+}
+
+func (controller *savesController) SaveTweet(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+
+	err := controller.usecase.Save(userID, tweetID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *savesController) UnsaveTweet(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+
+	err := controller.usecase.Unsave(userID, tweetID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *savesController) GetSavedTweets(c *gin.Context) {
+	userID := c.Param("user_id")
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	tweets, nextCursor, err := controller.usecase.GetSavedTweets(userID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(tweets, paginationMeta(nextCursor)))
+}
@@ -0,0 +1,119 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	saveMocks "github.com/jordyf15/tweeter-api/save/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSaveController(t *testing.T) {
+	suite.Run(t, new(saveControllerSuite))
+}
+
+type saveControllerSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	response    *httptest.ResponseRecorder
+	controller  controllers.SavesController
+	context     *gin.Context
+	saveUsecase *saveMocks.Usecase
+}
+
+var (
+	sctTweet = &models.Tweet{
+		ID:          "tweetID",
+		User:        &models.User{ID: "userID2", Username: "username2"},
+		Description: "description",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	sctNextCursor = utils.NewCursor(time.Now(), "tweetID")
+)
+
+func (s *saveControllerSuite) SetupTest() {
+	s.saveUsecase = new(saveMocks.Usecase)
+
+	unsave := func(userID, tweetID string) error {
+		if tweetID == "unsavedTweetID" {
+			return custom_errors.ErrNotSaved
+		}
+
+		return nil
+	}
+
+	s.saveUsecase.On("Save", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	s.saveUsecase.On("Unsave", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(unsave)
+	s.saveUsecase.On("GetSavedTweets", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.Tweet{sctTweet}, sctNextCursor, nil)
+
+	s.controller = controllers.NewSavesController(s.saveUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	s.router.POST("/tweets/:tweet_id/save", setCurrentUser, s.controller.SaveTweet)
+	s.router.DELETE("/tweets/:tweet_id/save", setCurrentUser, s.controller.UnsaveTweet)
+	s.router.GET("/users/:user_id/saves", setCurrentUser, s.controller.GetSavedTweets)
+}
+
+func (s *saveControllerSuite) TestSaveTweetSuccessful() {
+	s.context.Request, _ = http.NewRequest("POST", "/tweets/tweetID/save", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+	s.saveUsecase.AssertCalled(s.T(), "Save", "userID", "tweetID")
+}
+
+func (s *saveControllerSuite) TestUnsaveTweetNotSaved() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("DELETE", "/tweets/unsavedTweetID/save", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrNotSaved.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrNotSaved.Code), error1["code"])
+}
+
+func (s *saveControllerSuite) TestGetSavedTweetsSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID/saves?per_page=1", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), sctNextCursor.Encode(), meta["next_cursor"])
+
+	s.saveUsecase.AssertCalled(s.T(), "GetSavedTweets", "userID", mock.Anything, 1)
+}
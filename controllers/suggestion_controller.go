@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/suggestion"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type SuggestionsController interface {
+	GetSuggestions(c *gin.Context)
+}
+
+type suggestionsController struct {
+	usecase suggestion.Usecase
+}
+
+func NewSuggestionsController(usecase suggestion.Usecase) SuggestionsController {
+	return &suggestionsController{usecase: usecase}
+}
+
+func (controller *suggestionsController) GetSuggestions(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+
+	users, err := controller.usecase.GetSuggestions(userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(users, nil))
+}
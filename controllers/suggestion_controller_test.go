@@ -0,0 +1,68 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/models"
+	suggestionMocks "github.com/jordyf15/tweeter-api/suggestion/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuggestionController(t *testing.T) {
+	suite.Run(t, new(suggestionControllerSuite))
+}
+
+type suggestionControllerSuite struct {
+	suite.Suite
+	router            *gin.Engine
+	response          *httptest.ResponseRecorder
+	controller        controllers.SuggestionsController
+	context           *gin.Context
+	suggestionUsecase *suggestionMocks.Usecase
+}
+
+func (s *suggestionControllerSuite) SetupTest() {
+	s.suggestionUsecase = new(suggestionMocks.Usecase)
+
+	s.suggestionUsecase.On("GetSuggestions", mock.AnythingOfType("string")).Return([]*models.UserProfile{
+		{User: &models.User{ID: "userID2", Username: "username2"}, FollowsYou: true},
+	}, nil)
+
+	s.controller = controllers.NewSuggestionsController(s.suggestionUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	s.router.GET("/users/suggestions", func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}, s.controller.GetSuggestions)
+}
+
+func (s *suggestionControllerSuite) TestGetSuggestionsSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/suggestions", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	user1 := data[0].(map[string]interface{})
+	assert.Equal(s.T(), "userID2", user1["id"])
+	assert.Equal(s.T(), false, user1["is_following"])
+	assert.Equal(s.T(), true, user1["follows_you"])
+
+	s.suggestionUsecase.AssertCalled(s.T(), "GetSuggestions", "userID")
+}
@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/timeline"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type TimelinesController interface {
+	GetHomeTimeline(c *gin.Context)
+}
+
+type timelinesController struct {
+	usecase timeline.Usecase
+}
+
+func NewTimelinesController(usecase timeline.Usecase) TimelinesController {
+	return &timelinesController{usecase: usecase}
+}
+
+func (controller *timelinesController) GetHomeTimeline(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	entries, nextCursor, err := controller.usecase.GetHomeTimeline(userID, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(entries, paginationMeta(nextCursor)))
+}
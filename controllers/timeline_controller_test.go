@@ -0,0 +1,110 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	timelineMocks "github.com/jordyf15/tweeter-api/timeline/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTimelineController(t *testing.T) {
+	suite.Run(t, new(timelineControllerSuite))
+}
+
+type timelineControllerSuite struct {
+	suite.Suite
+	router          *gin.Engine
+	response        *httptest.ResponseRecorder
+	controller      controllers.TimelinesController
+	context         *gin.Context
+	timelineUsecase *timelineMocks.Usecase
+}
+
+var (
+	tlctEntry = &models.TimelineEntry{
+		ID:      "tweetID:userID2",
+		TweetID: "tweetID",
+		Tweet: &models.Tweet{
+			ID:          "tweetID",
+			User:        &models.User{ID: "userID3", Username: "username3"},
+			Description: "description",
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		},
+		RetweetedBy: &models.User{ID: "userID2", Username: "username2"},
+		CreatedAt:   time.Now(),
+	}
+	tlctNextCursor = utils.NewCursor(time.Now(), "tweetID:userID2")
+)
+
+func (s *timelineControllerSuite) SetupTest() {
+	s.timelineUsecase = new(timelineMocks.Usecase)
+
+	s.timelineUsecase.On("GetHomeTimeline", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.TimelineEntry{tlctEntry}, tlctNextCursor, nil)
+
+	s.controller = controllers.NewTimelinesController(s.timelineUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}
+
+	s.router.GET("/timeline/home", setCurrentUser, s.controller.GetHomeTimeline)
+}
+
+func (s *timelineControllerSuite) TestGetHomeTimelineInvalidCursor() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/timeline/home?cursor=%25%25", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), float64(custom_errors.ErrInvalidCursor.Code), error1["code"])
+	s.timelineUsecase.AssertNumberOfCalls(s.T(), "GetHomeTimeline", 0)
+}
+
+func (s *timelineControllerSuite) TestGetHomeTimelineSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/timeline/home?cursor="+tlctNextCursor.Encode(), nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	entry := data[0].(map[string]interface{})
+	assert.Equal(s.T(), tlctEntry.ID, entry["id"])
+	assert.Equal(s.T(), tlctEntry.Tweet.ID, entry["tweet"].(map[string]interface{})["id"])
+	assert.Equal(s.T(), tlctEntry.RetweetedBy.ID, entry["retweeted_by"].(map[string]interface{})["id"])
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), tlctNextCursor.Encode(), meta["next_cursor"])
+
+	s.timelineUsecase.AssertCalled(s.T(), "GetHomeTimeline", "userID", mock.AnythingOfType("*utils.Cursor"), 20)
+}
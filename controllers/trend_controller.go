@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/trend"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type TrendsController interface {
+	GetTrends(c *gin.Context)
+}
+
+type trendsController struct {
+	usecase trend.Usecase
+}
+
+func NewTrendsController(usecase trend.Usecase) TrendsController {
+	return &trendsController{usecase: usecase}
+}
+
+func (controller *trendsController) GetTrends(c *gin.Context) {
+	window := models.TrendWindow(c.DefaultQuery("window", string(models.TrendWindowDay)))
+
+	trends, err := controller.usecase.GetTrends(window)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(trends, map[string]interface{}{"window": window}))
+}
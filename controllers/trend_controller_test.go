@@ -0,0 +1,85 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	trendMocks "github.com/jordyf15/tweeter-api/trend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTrendController(t *testing.T) {
+	suite.Run(t, new(trendControllerSuite))
+}
+
+type trendControllerSuite struct {
+	suite.Suite
+	router       *gin.Engine
+	response     *httptest.ResponseRecorder
+	controller   controllers.TrendsController
+	context      *gin.Context
+	trendUsecase *trendMocks.Usecase
+}
+
+func (s *trendControllerSuite) SetupTest() {
+	s.trendUsecase = new(trendMocks.Usecase)
+
+	s.trendUsecase.On("GetTrends", models.TrendWindow("30d")).Return(nil, custom_errors.ErrInvalidTrendWindow)
+	s.trendUsecase.On("GetTrends", mock.AnythingOfType("models.TrendWindow")).Return([]*models.Trend{{Name: "golang", Count: 12, Score: 10.5}}, nil)
+
+	s.controller = controllers.NewTrendsController(s.trendUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	s.router.GET("/trends", s.controller.GetTrends)
+}
+
+func (s *trendControllerSuite) TestGetTrendsInvalidWindow() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/trends?window=30d", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrInvalidTrendWindow.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrInvalidTrendWindow.Code), error1["code"])
+}
+
+func (s *trendControllerSuite) TestGetTrendsDefaultWindow() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/trends", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	trend1 := data[0].(map[string]interface{})
+	assert.Equal(s.T(), "golang", trend1["name"])
+	assert.Equal(s.T(), float64(12), trend1["count"])
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), string(models.TrendWindowDay), meta["window"])
+	s.trendUsecase.AssertCalled(s.T(), "GetTrends", models.TrendWindowDay)
+}
@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/comment"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type TweetsController interface {
+	CreateTweet(c *gin.Context)
+	GetTweet(c *gin.Context)
+	DeleteTweet(c *gin.Context)
+	GetUserTweets(c *gin.Context)
+}
+
+type tweetsController struct {
+	usecase        tweet.Usecase
+	commentUsecase comment.Usecase
+}
+
+func NewTweetsController(usecase tweet.Usecase, commentUsecase comment.Usecase) TweetsController {
+	return &tweetsController{usecase: usecase, commentUsecase: commentUsecase}
+}
+
+func (controller *tweetsController) CreateTweet(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+
+	_tweet := &models.Tweet{}
+	_tweet.UserID = userID
+	_tweet.Description = c.PostForm("description")
+	_tweet.ReplyConstraint = models.TweetReplyConstraint(c.PostForm("reply_constraint"))
+
+	imageFiles := make([]utils.NamedFileReader, 0)
+	if form, err := c.MultipartForm(); err == nil {
+		imageHeaders := form.File["images"]
+		if len(imageHeaders) > tweet.MaxImageCount {
+			respondBasedOnError(c, custom_errors.ErrTweetImageCountExceeded)
+			return
+		}
+
+		for _, imageHeader := range imageHeaders {
+			if imageHeader.Size > pictureSizesInMb*5 {
+				respondBasedOnError(c, custom_errors.ErrTweetImageTooLarge)
+				return
+			}
+
+			file, err := imageHeader.Open()
+			if err != nil {
+				fmt.Println(err.Error())
+				continue
+			}
+			imageFiles = append(imageFiles, utils.NewNamedFileReader(file, imageHeader.Filename))
+		}
+	}
+
+	createdTweet, err := controller.usecase.Create(_tweet, imageFiles)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, createdTweet)
+}
+
+func (controller *tweetsController) GetTweet(c *gin.Context) {
+	viewerID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+
+	_tweet, err := controller.usecase.Get(tweetID, viewerID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _tweet)
+}
+
+func (controller *tweetsController) DeleteTweet(c *gin.Context) {
+	userID := c.MustGet("current_user_id").(string)
+	tweetID := c.Param("tweet_id")
+
+	err := controller.usecase.Delete(tweetID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (controller *tweetsController) GetUserTweets(c *gin.Context) {
+	viewerID := c.MustGet("current_user_id").(string)
+	userID := c.Param("user_id")
+
+	filter := models.UserTweetFilter(c.DefaultQuery("filter", string(models.UserTweetFilterTweets)))
+
+	cursor, limit, err := getPaginationParams(c)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	if filter == models.UserTweetFilterReplies {
+		comments, nextCursor, err := controller.commentUsecase.GetUserComments(viewerID, userID, cursor, limit)
+		if err != nil {
+			respondBasedOnError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, utils.DataResponse(comments, paginationMeta(nextCursor)))
+		return
+	}
+
+	tweets, nextCursor, err := controller.usecase.GetUserTweets(viewerID, userID, filter, cursor, limit)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.DataResponse(tweets, paginationMeta(nextCursor)))
+}
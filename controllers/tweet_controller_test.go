@@ -0,0 +1,287 @@
+package controllers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	commentMocks "github.com/jordyf15/tweeter-api/comment/mocks"
+	"github.com/jordyf15/tweeter-api/controllers"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	tweetMocks "github.com/jordyf15/tweeter-api/tweet/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTweetController(t *testing.T) {
+	suite.Run(t, new(tweetControllerSuite))
+}
+
+type tweetControllerSuite struct {
+	suite.Suite
+	router         *gin.Engine
+	response       *httptest.ResponseRecorder
+	controller     controllers.TweetsController
+	context        *gin.Context
+	tweetUsecase   *tweetMocks.Usecase
+	commentUsecase *commentMocks.Usecase
+}
+
+var (
+	tctTweet = &models.Tweet{
+		ID:              "tweetID",
+		UserID:          "userID",
+		User:            &models.User{ID: "userID", Username: "username"},
+		Description:     "description",
+		ReplyConstraint: "everyone",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	tctComment = &models.Comment{
+		ID:        "commentID",
+		TweetID:   "tweetID",
+		UserID:    "userID",
+		Comment:   "comment",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	tctNextCursor = utils.NewCursor(time.Now(), "tweetID")
+)
+
+func (s *tweetControllerSuite) SetupTest() {
+	s.tweetUsecase = new(tweetMocks.Usecase)
+	s.commentUsecase = new(commentMocks.Usecase)
+
+	deleteTweet := func(tweetID, userID string) error {
+		if userID != tctTweet.UserID {
+			return custom_errors.ErrTweetDeleteForbidden
+		}
+
+		return nil
+	}
+
+	getUserTweets := func(viewerID, userID string, filter models.UserTweetFilter, cursor *utils.Cursor, limit int) error {
+		if filter != models.UserTweetFilterTweets && filter != models.UserTweetFilterMedia && filter != models.UserTweetFilterLikes {
+			return custom_errors.ErrInvalidUserTweetFilter
+		}
+
+		return nil
+	}
+
+	s.tweetUsecase.On("Create", mock.AnythingOfType("*models.Tweet"), mock.Anything).Return(tctTweet, nil)
+	s.tweetUsecase.On("Get", "blockedTweetID", mock.AnythingOfType("string")).Return(nil, custom_errors.ErrUserBlocked)
+	s.tweetUsecase.On("Get", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(tctTweet, nil)
+	s.tweetUsecase.On("Delete", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(deleteTweet)
+	s.tweetUsecase.On("GetUserTweets", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.UserTweetFilter"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.Tweet{tctTweet}, tctNextCursor, getUserTweets)
+	s.commentUsecase.On("GetUserComments", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.Comment{tctComment}, nil, nil)
+
+	s.controller = controllers.NewTweetsController(s.tweetUsecase, s.commentUsecase)
+	s.response = httptest.NewRecorder()
+	s.context, s.router = gin.CreateTestContext(s.response)
+
+	s.router.POST("/tweets", func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}, s.controller.CreateTweet)
+	s.router.GET("/tweets/:tweet_id", func(c *gin.Context) {
+		c.Set("current_user_id", "userID")
+		c.Next()
+	}, s.controller.GetTweet)
+	s.router.DELETE("/tweets/:tweet_id", func(c *gin.Context) {
+		c.Set("current_user_id", c.GetHeader("X-User-ID"))
+		c.Next()
+	}, s.controller.DeleteTweet)
+	s.router.GET("/users/:user_id/tweets", func(c *gin.Context) {
+		c.Set("current_user_id", "viewerID")
+		c.Next()
+	}, s.controller.GetUserTweets)
+}
+
+func (s *tweetControllerSuite) TestCreateTweetSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	description, _ := writer.CreateFormField("description")
+	description.Write([]byte(tctTweet.Description))
+	writer.Close()
+
+	s.context.Request, _ = http.NewRequest("POST", "/tweets", buf)
+	s.context.Request.Header.Set("Content-Type", writer.FormDataContentType())
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), tctTweet.ID, receivedResponse["id"])
+	assert.Equal(s.T(), tctTweet.Description, receivedResponse["description"])
+	assert.Equal(s.T(), string(tctTweet.ReplyConstraint), receivedResponse["reply_constraint"])
+
+	user, isExist := receivedResponse["user"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), tctTweet.User.Username, user["username"])
+}
+
+func (s *tweetControllerSuite) TestCreateTweetImageCountExceeded() {
+	var receivedResponse map[string]interface{}
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	description, _ := writer.CreateFormField("description")
+	description.Write([]byte(tctTweet.Description))
+
+	for i := 0; i < 5; i++ {
+		imgHeader, _ := writer.CreateFormFile("images", "../assets/images/default-banner.jpg")
+		file, _ := os.Open("../assets/images/default-banner.jpg")
+		_, _ = io.Copy(imgHeader, file)
+		file.Close()
+	}
+	writer.Close()
+
+	s.context.Request, _ = http.NewRequest("POST", "/tweets", buf)
+	s.context.Request.Header.Set("Content-Type", writer.FormDataContentType())
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrTweetImageCountExceeded.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrTweetImageCountExceeded.Code), error1["code"])
+}
+
+func (s *tweetControllerSuite) TestGetTweetSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/tweets/tweetID", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), tctTweet.ID, receivedResponse["id"])
+	assert.Equal(s.T(), tctTweet.Description, receivedResponse["description"])
+}
+
+func (s *tweetControllerSuite) TestGetTweetBlocked() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/tweets/blockedTweetID", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrUserBlocked.Code), error1["code"])
+}
+
+func (s *tweetControllerSuite) TestDeleteTweetForbidden() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("DELETE", "/tweets/tweetID", nil)
+	s.context.Request.Header.Set("X-User-ID", "otherUserID")
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrTweetDeleteForbidden.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrTweetDeleteForbidden.Code), error1["code"])
+}
+
+func (s *tweetControllerSuite) TestDeleteTweetSuccessful() {
+	s.context.Request, _ = http.NewRequest("DELETE", "/tweets/tweetID", nil)
+	s.context.Request.Header.Set("X-User-ID", "userID")
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNoContent, s.response.Code)
+}
+
+func (s *tweetControllerSuite) TestGetUserTweetsDefaultFilter() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID/tweets?per_page=1", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Equal(s.T(), tctNextCursor.Encode(), meta["next_cursor"])
+
+	s.tweetUsecase.AssertCalled(s.T(), "GetUserTweets", "viewerID", "userID", models.UserTweetFilterTweets, mock.Anything, 1)
+}
+
+func (s *tweetControllerSuite) TestGetUserTweetsReplies() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID/tweets?filter=replies", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	data, isExist := receivedResponse["data"].([]interface{})
+	assert.True(s.T(), isExist)
+	assert.Len(s.T(), data, 1)
+	assert.Equal(s.T(), tctComment.ID, data[0].(map[string]interface{})["id"])
+
+	meta, isExist := receivedResponse["meta"].(map[string]interface{})
+	assert.True(s.T(), isExist)
+	assert.Nil(s.T(), meta["next_cursor"])
+
+	s.commentUsecase.AssertCalled(s.T(), "GetUserComments", "viewerID", "userID", mock.Anything, 20)
+	s.tweetUsecase.AssertNumberOfCalls(s.T(), "GetUserTweets", 0)
+}
+
+func (s *tweetControllerSuite) TestGetUserTweetsInvalidFilter() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/userID/tweets?filter=random", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	errors, isExist := receivedResponse["errors"].([]interface{})
+	assert.True(s.T(), isExist)
+
+	error1 := errors[0].(map[string]interface{})
+	assert.Equal(s.T(), custom_errors.ErrInvalidUserTweetFilter.Message, error1["message"])
+	assert.Equal(s.T(), float64(custom_errors.ErrInvalidUserTweetFilter.Code), error1["code"])
+}
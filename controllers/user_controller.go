@@ -16,6 +16,8 @@ type UsersController interface {
 	Login(c *gin.Context)
 	ChangeUserPassword(c *gin.Context)
 	EditUserProfile(c *gin.Context)
+	GetUser(c *gin.Context)
+	GetUserByUsername(c *gin.Context)
 }
 
 type usersController struct {
@@ -118,6 +120,10 @@ func (controller *usersController) EditUserProfile(c *gin.Context) {
 		updates["email"] = newEmail
 	}
 
+	if newIsProtected, isExist := c.GetPostForm("is_protected"); isExist {
+		updates["is_protected"] = newIsProtected
+	}
+
 	willRemoveProfileImage := false
 	if removeProfileImage := c.PostForm("is_remove_profile_image"); removeProfileImage == "true" {
 		willRemoveProfileImage = true
@@ -173,5 +179,31 @@ func (controller *usersController) EditUserProfile(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, user)
+	c.JSON(http.StatusOK, &models.CurrentUser{User: user, Email: user.Email})
+}
+
+func (controller *usersController) GetUser(c *gin.Context) {
+	viewerID := c.MustGet("current_user_id").(string)
+	userID := c.Param("user_id")
+
+	profile, err := controller.userUsecase.GetProfile(viewerID, userID)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+func (controller *usersController) GetUserByUsername(c *gin.Context) {
+	viewerID := c.MustGet("current_user_id").(string)
+	username := c.Param("username")
+
+	profile, err := controller.userUsecase.GetProfileByUsername(viewerID, username)
+	if err != nil {
+		respondBasedOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
 }
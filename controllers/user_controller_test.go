@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 func TestUserController(t *testing.T) {
@@ -67,7 +68,7 @@ var (
 func (s *userControllerSuite) SetupTest() {
 	userUsecase := new(userMocks.Usecase)
 
-	response := utils.DataResponse(uctUser, map[string]interface{}{
+	response := utils.DataResponse(&models.CurrentUser{User: uctUser, Email: uctUser.Email}, map[string]interface{}{
 		"access_token":  "accessToken",
 		"refresh_token": "refreshToken",
 		"expires_at":    1,
@@ -76,16 +77,25 @@ func (s *userControllerSuite) SetupTest() {
 	userUsecase.On("Create", mock.AnythingOfType("*models.User")).Return(response, nil)
 	userUsecase.On("Login", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(response, nil)
 	userUsecase.On("ChangeUserPassword", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	userUsecase.On("GetProfile", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(&models.UserProfile{User: uctUser, IsFollowing: true}, nil)
+	userUsecase.On("GetProfileByUsername", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
 	userUsecase.On("EditUserProfile", mock.AnythingOfType("string"), mock.AnythingOfType("map[string]string"), mock.Anything, mock.Anything, mock.AnythingOfType("bool"), mock.AnythingOfType("bool")).Return(uctUser, nil)
 
 	s.controller = controllers.NewUsersController(userUsecase)
 	s.response = httptest.NewRecorder()
 	s.context, s.router = gin.CreateTestContext(s.response)
 
+	setCurrentUser := func(c *gin.Context) {
+		c.Set("current_user_id", "viewerID")
+		c.Next()
+	}
+
 	s.router.POST("/register", s.controller.Register)
 	s.router.POST("/login", s.controller.Login)
 	s.router.POST("users/:user_id/password/change", s.controller.ChangeUserPassword)
 	s.router.PATCH("/users/:user_id", s.controller.EditUserProfile)
+	s.router.GET("/users/:user_id", setCurrentUser, s.controller.GetUser)
+	s.router.GET("/users/by-username/:username", setCurrentUser, s.controller.GetUserByUsername)
 }
 
 func (s *userControllerSuite) TestCreateUser() {
@@ -446,3 +456,27 @@ func (s *userControllerSuite) TestEditUserProfileSuccessful() {
 	assert.True(s.T(), isExist)
 	assert.Equal(s.T(), uctUser.BackgroundImage.URL, url)
 }
+
+func (s *userControllerSuite) TestGetUserSuccessful() {
+	var receivedResponse map[string]interface{}
+
+	s.context.Request, _ = http.NewRequest("GET", "/users/"+uctUser.ID, nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusOK, s.response.Code)
+
+	json.NewDecoder(s.response.Body).Decode(&receivedResponse)
+
+	assert.Equal(s.T(), uctUser.ID, receivedResponse["id"])
+	assert.Equal(s.T(), uctUser.Username, receivedResponse["username"])
+	assert.NotContains(s.T(), receivedResponse, "email")
+	assert.Equal(s.T(), true, receivedResponse["is_following"])
+	assert.Equal(s.T(), false, receivedResponse["follows_you"])
+}
+
+func (s *userControllerSuite) TestGetUserByUsernameNotFound() {
+	s.context.Request, _ = http.NewRequest("GET", "/users/by-username/unknown", nil)
+	s.router.ServeHTTP(s.response, s.context.Request)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.response.Code)
+}
@@ -8,6 +8,10 @@ var (
 	ErrRecordNotFound = newErr(101, "Record not found")
 	// ErrUnknownErrorOccured Error when an unknown error has occured
 	ErrUnknownErrorOccured = newErr(102, "Unknown error occured")
+	// ErrInvalidCursor Error when the pagination cursor could not be decoded
+	ErrInvalidCursor = newErr(103, "Invalid cursor")
+	// ErrInvalidSortOrder Error when the requested sort order is not supported
+	ErrInvalidSortOrder = newErr(104, "Order must be newest or oldest")
 
 	//token errors
 	// ErrMalformedRefreshToken Refresh token couldn't be parsed.
@@ -64,10 +68,16 @@ var (
 	ErrProfileImageInvalidFormat = newErr(319, "Profile image must be in JPEG format")
 	// ErrBackgroundImageInvalidFormat Error returned when the uploaded background image's format is not valid
 	ErrBackgroundImageInvalidFormat = newErr(320, "Background image must be in JPEG format")
+	// ErrIsProtectedInvalid Error returned when the inputted is_protected is not a boolean
+	ErrIsProtectedInvalid = newErr(321, "is_protected must be true or false")
 
 	// Follow Errors
 	// ErrMatchedFollowerIDAndFollowingID Error returned when the follower ID and following ID is the same
 	ErrMatchedFollowerIDAndFollowingID = newErr(401, "Follower ID and Following ID cannot be the same")
+	// ErrFollowBlocked Error returned when either user has blocked the other
+	ErrFollowBlocked = newErr(402, "Cannot follow a user who has blocked you or whom you have blocked")
+	// ErrFollowRequestNotFound Error returned when the user approves or denies a follow request that does not exist
+	ErrFollowRequestNotFound = newErr(403, "Follow request not found")
 
 	// Group Errors
 	// ErrGroupNameTooShort Error returned when the inputted name is too short
@@ -82,6 +92,117 @@ var (
 	ErrGroupImageInvalidFormat = newErr(505, "Group image must be in JPEG format")
 	// ErrGroupImageMissing Error returned when no group image is uploaded
 	ErrGroupImageMissing = newErr(506, "Group image cannot be empty")
+	// ErrInvalidGroupSort Error returned when the requested group sort is not supported
+	ErrInvalidGroupSort = newErr(507, "Sort must be member_count or created_at")
+	// 508 was ErrGroupClosed, retired once closed groups started taking join requests
+	// ErrAlreadyGroupMember Error returned when the user is already a member of the group
+	ErrAlreadyGroupMember = newErr(509, "User is already a member of this group")
+	// ErrNotGroupMember Error returned when the user is not a member of the group
+	ErrNotGroupMember = newErr(510, "User is not a member of this group")
+	// ErrLastGroupAdmin Error returned when the only admin of a group tries to leave it
+	ErrLastGroupAdmin = newErr(511, "The last admin must hand over the admin role before leaving the group")
+	// ErrGroupJoinRequestNotFound Error returned when the join request does not exist in the group
+	ErrGroupJoinRequestNotFound = newErr(512, "Join request not found")
+	// ErrGroupPermissionDenied Error returned when the user's role in the group does not allow the action
+	ErrGroupPermissionDenied = newErr(513, "User does not have permission to do this in the group")
+	// ErrGroupInvitationAlreadyExist Error returned when the invitee already has a pending invitation to the group
+	ErrGroupInvitationAlreadyExist = newErr(514, "User is already invited to this group")
+	// ErrGroupInvitationNotFound Error returned when the invitation does not exist, has expired or belongs to another user
+	ErrGroupInvitationNotFound = newErr(515, "Group invitation not found")
+	// ErrGroupInviteeMissing Error returned when neither the invitee's id nor username is given
+	ErrGroupInviteeMissing = newErr(516, "Invitee id or username must be given")
+	// ErrInvalidGroupMemberRole Error returned when the requested role is not a group member role
+	ErrInvalidGroupMemberRole = newErr(517, "Role must be member, moderator or admin")
+	// ErrGroupJoinRequestAlreadyExist Error returned when the user already requested to join the group
+	ErrGroupJoinRequestAlreadyExist = newErr(518, "User already requested to join this group")
+	// ErrIsOpenInvalid Error returned when the inputted is_open is not a boolean
+	ErrIsOpenInvalid = newErr(519, "is_open must be true or false")
+
+	// Tweet Errors
+	// ErrTweetDescriptionTooShort Error returned when the inputted description is empty
+	ErrTweetDescriptionTooShort = newErr(601, "Tweet description cannot be empty")
+	// ErrTweetDescriptionTooLong Error returned when the inputted description is too long
+	ErrTweetDescriptionTooLong = newErr(602, "Tweet description must be at most 280 characters")
+	// ErrTweetDeleteForbidden Error returned when a user tries to delete a tweet they did not author
+	ErrTweetDeleteForbidden = newErr(603, "Only the author can delete this tweet")
+	// ErrTweetImageTooLarge Error returned when one of the uploaded tweet images is too large
+	ErrTweetImageTooLarge = newErr(604, "Tweet image must be less than 5MB")
+	// ErrTweetImageInvalidFormat Error returned when one of the uploaded tweet images' format is invalid
+	ErrTweetImageInvalidFormat = newErr(605, "Tweet image must be in JPEG or PNG format")
+	// ErrTweetImageCountExceeded Error returned when more images are uploaded than a tweet can hold
+	ErrTweetImageCountExceeded = newErr(606, "Tweet can have at most 4 images")
+	// ErrTweetReplyConstraintInvalid Error returned when the inputted reply constraint is not one of the supported values
+	ErrTweetReplyConstraintInvalid = newErr(607, "Tweet reply constraint must be everyone or following-only")
+	// ErrTweetReplyRestricted Error returned when a user replies to a following-only tweet whose author does not follow them
+	ErrTweetReplyRestricted = newErr(608, "Only users followed by the author can reply to this tweet")
+	// ErrInvalidUserTweetFilter Error returned when the requested user tweet filter is not one of the supported values
+	ErrInvalidUserTweetFilter = newErr(609, "Filter must be tweets, replies, media or likes")
+	// ErrTweetProtected Error returned when a user who does not follow a protected user tries to see their tweets
+	ErrTweetProtected = newErr(610, "Only followers can see this user's tweets")
+
+	// Comment Errors
+	// ErrCommentTooShort Error returned when the inputted comment is empty
+	ErrCommentTooShort = newErr(701, "Comment cannot be empty")
+	// ErrCommentTooLong Error returned when the inputted comment is too long
+	ErrCommentTooLong = newErr(702, "Comment must be at most 280 characters")
+	// ErrCommentImageTooLarge Error returned when one of the uploaded comment images is too large
+	ErrCommentImageTooLarge = newErr(703, "Comment image must be less than 5MB")
+	// ErrCommentImageInvalidFormat Error returned when one of the uploaded comment images' format is invalid
+	ErrCommentImageInvalidFormat = newErr(704, "Comment image must be in JPEG or PNG format")
+	// ErrCommentImageCountExceeded Error returned when more images are uploaded than a comment can hold
+	ErrCommentImageCountExceeded = newErr(705, "Comment can have at most 4 images")
+	// ErrCommentDeleteForbidden Error returned when a user tries to delete a comment they did not write
+	ErrCommentDeleteForbidden = newErr(706, "Only the author can delete this comment")
+
+	// Like Errors
+	// ErrAlreadyLiked Error returned when the user has already liked the tweet or comment
+	ErrAlreadyLiked = newErr(801, "Already liked")
+	// ErrNotLiked Error returned when the user unlikes a tweet or comment they have not liked
+	ErrNotLiked = newErr(802, "Not liked yet")
+	// ErrInvalidLikeResourceType Error returned when the liked resource is neither a tweet nor a comment
+	ErrInvalidLikeResourceType = newErr(803, "Only tweets and comments can be liked")
+
+	// Retweet Errors
+	// ErrAlreadyRetweeted Error returned when the user has already retweeted the tweet
+	ErrAlreadyRetweeted = newErr(901, "Already retweeted")
+	// ErrNotRetweeted Error returned when the user undoes a retweet they have not made
+	ErrNotRetweeted = newErr(902, "Not retweeted yet")
+	// ErrRetweetTweetNotFound Error returned when the tweet to retweet does not exist or has been deleted
+	ErrRetweetTweetNotFound = newErr(903, "Tweet to retweet does not exist or has been deleted")
+
+	// Save Errors
+	// ErrAlreadySaved Error returned when the user has already saved the tweet
+	ErrAlreadySaved = newErr(1001, "Already saved")
+	// ErrNotSaved Error returned when the user unsaves a tweet they have not saved
+	ErrNotSaved = newErr(1002, "Not saved yet")
+
+	// Trend Errors
+	// ErrInvalidTrendWindow Error returned when the requested trend window is not supported
+	ErrInvalidTrendWindow = newErr(1101, "Window must be 1h, 24h or 7d")
+
+	// Block Errors
+	// ErrBlockSelf Error returned when the user tries to block or unblock themselves
+	ErrBlockSelf = newErr(1201, "Cannot block yourself")
+	// ErrAlreadyBlocked Error returned when the user has already blocked the other user
+	ErrAlreadyBlocked = newErr(1202, "Already blocked")
+	// ErrNotBlocked Error returned when the user unblocks a user they have not blocked
+	ErrNotBlocked = newErr(1203, "Not blocked yet")
+	// ErrUserBlocked Error returned when the user interacts with content of a user who has blocked them or whom they have blocked
+	ErrUserBlocked = newErr(1204, "Cannot interact with a user who has blocked you or whom you have blocked")
+
+	// Mute Errors
+	// ErrMuteSelf Error returned when the user tries to mute themselves
+	ErrMuteSelf = newErr(1301, "Cannot mute yourself")
+	// ErrMuteTargetInvalid Error returned when neither or both of a user and a keyword are given to mute
+	ErrMuteTargetInvalid = newErr(1302, "Either a user or a keyword must be muted")
+	// ErrMuteKeywordTooLong Error returned when the inputted muted keyword is too long
+	ErrMuteKeywordTooLong = newErr(1303, "Muted keyword must be at most 100 characters")
+	// ErrInvalidMuteDuration Error returned when the requested mute duration is not supported
+	ErrInvalidMuteDuration = newErr(1304, "Duration must be 24h, 7d or 30d")
+	// ErrAlreadyMuted Error returned when the user has already muted the user or keyword
+	ErrAlreadyMuted = newErr(1305, "Already muted")
+	// ErrNotMuted Error returned when the user unmutes a user or keyword they have not muted
+	ErrNotMuted = newErr(1306, "Not muted yet")
 )
 
 type Error struct {
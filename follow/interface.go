@@ -1,11 +1,36 @@
 package follow
 
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
 type Repository interface {
-	Create(followerID, followingID string) error
-	Delete(followerID, followingID string) error
+	Create(followerID, followingID string) (bool, error)
+	Delete(followerID, followingID string) (bool, error)
+	IsFollowing(followerID, followingID string) (bool, error)
+	GetFollowerIDs(followingID string) ([]string, error)
+	GetFollowingIDsWithMinFollowerCount(followerID string, minFollowerCount uint) ([]string, error)
+	GetFollowingIDsAmong(followerID string, userIDs []string) ([]string, error)
+	GetFollowerIDsAmong(followingID string, userIDs []string) ([]string, error)
+	GetFollowers(followingID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error)
+	GetFollowings(followerID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error)
+	GetFollowersFollowedBy(followingID, viewerID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error)
+	CreateRequest(followerID, followingID string) (bool, error)
+	DeleteRequest(followerID, followingID string) (bool, error)
+	IsRequested(followerID, followingID string) (bool, error)
+	GetRequests(followingID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error)
+	ApproveRequest(followerID, followingID string) (bool, error)
+	GetProtectedIDsNotFollowedAmong(viewerID string, userIDs []string) ([]string, error)
 }
 
 type Usecase interface {
-	FollowUser(followerID, followingID string) error
-	UnfollowUser(followerID, followingID string) error
+	FollowUser(followerID, followingID string) (*models.Relationship, error)
+	UnfollowUser(followerID, followingID string) (*models.Relationship, error)
+	GetFollowers(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error)
+	GetFollowings(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error)
+	GetFollowersYouKnow(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error)
+	GetFollowRequests(userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error)
+	ApproveFollowRequest(userID, requesterID string) error
+	DenyFollowRequest(userID, requesterID string) error
 }
@@ -2,39 +2,370 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
 
 // Repository is an autogenerated mock type for the Repository type
 type Repository struct {
 	mock.Mock
 }
 
+// ApproveRequest provides a mock function with given fields: followerID, followingID
+func (_m *Repository) ApproveRequest(followerID string, followingID string) (bool, error) {
+	ret := _m.Called(followerID, followingID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: followerID, followingID
-func (_m *Repository) Create(followerID string, followingID string) error {
+func (_m *Repository) Create(followerID string, followingID string) (bool, error) {
+	ret := _m.Called(followerID, followingID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRequest provides a mock function with given fields: followerID, followingID
+func (_m *Repository) CreateRequest(followerID string, followingID string) (bool, error) {
 	ret := _m.Called(followerID, followingID)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
 		r0 = rf(followerID, followingID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // Delete provides a mock function with given fields: followerID, followingID
-func (_m *Repository) Delete(followerID string, followingID string) error {
+func (_m *Repository) Delete(followerID string, followingID string) (bool, error) {
+	ret := _m.Called(followerID, followingID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRequest provides a mock function with given fields: followerID, followingID
+func (_m *Repository) DeleteRequest(followerID string, followingID string) (bool, error) {
 	ret := _m.Called(followerID, followingID)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
 		r0 = rf(followerID, followingID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowerIDs provides a mock function with given fields: followingID
+func (_m *Repository) GetFollowerIDs(followingID string) ([]string, error) {
+	ret := _m.Called(followingID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(followingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowerIDsAmong provides a mock function with given fields: followingID, userIDs
+func (_m *Repository) GetFollowerIDsAmong(followingID string, userIDs []string) ([]string, error) {
+	ret := _m.Called(followingID, userIDs)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, []string) []string); ok {
+		r0 = rf(followingID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(followingID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowers provides a mock function with given fields: followingID, cursor, limit
+func (_m *Repository) GetFollowers(followingID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error) {
+	ret := _m.Called(followingID, cursor, limit)
+
+	var r0 []*models.Follow
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.Follow); ok {
+		r0 = rf(followingID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Follow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) error); ok {
+		r1 = rf(followingID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowersFollowedBy provides a mock function with given fields: followingID, viewerID, cursor, limit
+func (_m *Repository) GetFollowersFollowedBy(followingID string, viewerID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error) {
+	ret := _m.Called(followingID, viewerID, cursor, limit)
+
+	var r0 []*models.Follow
+	if rf, ok := ret.Get(0).(func(string, string, *utils.Cursor, int) []*models.Follow); ok {
+		r0 = rf(followingID, viewerID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Follow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *utils.Cursor, int) error); ok {
+		r1 = rf(followingID, viewerID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowingIDsAmong provides a mock function with given fields: followerID, userIDs
+func (_m *Repository) GetFollowingIDsAmong(followerID string, userIDs []string) ([]string, error) {
+	ret := _m.Called(followerID, userIDs)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, []string) []string); ok {
+		r0 = rf(followerID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(followerID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowingIDsWithMinFollowerCount provides a mock function with given fields: followerID, minFollowerCount
+func (_m *Repository) GetFollowingIDsWithMinFollowerCount(followerID string, minFollowerCount uint) ([]string, error) {
+	ret := _m.Called(followerID, minFollowerCount)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, uint) []string); ok {
+		r0 = rf(followerID, minFollowerCount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint) error); ok {
+		r1 = rf(followerID, minFollowerCount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowings provides a mock function with given fields: followerID, cursor, limit
+func (_m *Repository) GetFollowings(followerID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error) {
+	ret := _m.Called(followerID, cursor, limit)
+
+	var r0 []*models.Follow
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.Follow); ok {
+		r0 = rf(followerID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Follow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) error); ok {
+		r1 = rf(followerID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProtectedIDsNotFollowedAmong provides a mock function with given fields: viewerID, userIDs
+func (_m *Repository) GetProtectedIDsNotFollowedAmong(viewerID string, userIDs []string) ([]string, error) {
+	ret := _m.Called(viewerID, userIDs)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, []string) []string); ok {
+		r0 = rf(viewerID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(viewerID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRequests provides a mock function with given fields: followingID, cursor, limit
+func (_m *Repository) GetRequests(followingID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error) {
+	ret := _m.Called(followingID, cursor, limit)
+
+	var r0 []*models.Follow
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.Follow); ok {
+		r0 = rf(followingID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Follow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) error); ok {
+		r1 = rf(followingID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsFollowing provides a mock function with given fields: followerID, followingID
+func (_m *Repository) IsFollowing(followerID string, followingID string) (bool, error) {
+	ret := _m.Called(followerID, followingID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsRequested provides a mock function with given fields: followerID, followingID
+func (_m *Repository) IsRequested(followerID string, followingID string) (bool, error) {
+	ret := _m.Called(followerID, followingID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
 type mockConstructorTestingTNewRepository interface {
@@ -2,20 +2,25 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
 
 // Usecase is an autogenerated mock type for the Usecase type
 type Usecase struct {
 	mock.Mock
 }
 
-// FollowUser provides a mock function with given fields: followerID, followingID
-func (_m *Usecase) FollowUser(followerID string, followingID string) error {
-	ret := _m.Called(followerID, followingID)
+// ApproveFollowRequest provides a mock function with given fields: userID, requesterID
+func (_m *Usecase) ApproveFollowRequest(userID string, requesterID string) error {
+	ret := _m.Called(userID, requesterID)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(followerID, followingID)
+		r0 = rf(userID, requesterID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -23,13 +28,13 @@ func (_m *Usecase) FollowUser(followerID string, followingID string) error {
 	return r0
 }
 
-// UnfollowUser provides a mock function with given fields: followerID, followingID
-func (_m *Usecase) UnfollowUser(followerID string, followingID string) error {
-	ret := _m.Called(followerID, followingID)
+// DenyFollowRequest provides a mock function with given fields: userID, requesterID
+func (_m *Usecase) DenyFollowRequest(userID string, requesterID string) error {
+	ret := _m.Called(userID, requesterID)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(followerID, followingID)
+		r0 = rf(userID, requesterID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -37,6 +42,180 @@ func (_m *Usecase) UnfollowUser(followerID string, followingID string) error {
 	return r0
 }
 
+// FollowUser provides a mock function with given fields: followerID, followingID
+func (_m *Usecase) FollowUser(followerID string, followingID string) (*models.Relationship, error) {
+	ret := _m.Called(followerID, followingID)
+
+	var r0 *models.Relationship
+	if rf, ok := ret.Get(0).(func(string, string) *models.Relationship); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Relationship)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowRequests provides a mock function with given fields: userID, cursor, limit
+func (_m *Usecase) GetFollowRequests(userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error) {
+	ret := _m.Called(userID, cursor, limit)
+
+	var r0 []*models.UserProfile
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.UserProfile); ok {
+		r0 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserProfile)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, *utils.Cursor, int) error); ok {
+		r2 = rf(userID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetFollowers provides a mock function with given fields: viewerID, userID, cursor, limit
+func (_m *Usecase) GetFollowers(viewerID string, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, userID, cursor, limit)
+
+	var r0 []*models.UserProfile
+	if rf, ok := ret.Get(0).(func(string, string, *utils.Cursor, int) []*models.UserProfile); ok {
+		r0 = rf(viewerID, userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserProfile)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(viewerID, userID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, *utils.Cursor, int) error); ok {
+		r2 = rf(viewerID, userID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetFollowersYouKnow provides a mock function with given fields: viewerID, userID, cursor, limit
+func (_m *Usecase) GetFollowersYouKnow(viewerID string, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, userID, cursor, limit)
+
+	var r0 []*models.UserProfile
+	if rf, ok := ret.Get(0).(func(string, string, *utils.Cursor, int) []*models.UserProfile); ok {
+		r0 = rf(viewerID, userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserProfile)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(viewerID, userID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, *utils.Cursor, int) error); ok {
+		r2 = rf(viewerID, userID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetFollowings provides a mock function with given fields: viewerID, userID, cursor, limit
+func (_m *Usecase) GetFollowings(viewerID string, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, userID, cursor, limit)
+
+	var r0 []*models.UserProfile
+	if rf, ok := ret.Get(0).(func(string, string, *utils.Cursor, int) []*models.UserProfile); ok {
+		r0 = rf(viewerID, userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserProfile)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(viewerID, userID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, *utils.Cursor, int) error); ok {
+		r2 = rf(viewerID, userID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UnfollowUser provides a mock function with given fields: followerID, followingID
+func (_m *Usecase) UnfollowUser(followerID string, followingID string) (*models.Relationship, error) {
+	ret := _m.Called(followerID, followingID)
+
+	var r0 *models.Relationship
+	if rf, ok := ret.Get(0).(func(string, string) *models.Relationship); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Relationship)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewUsecase interface {
 	mock.TestingT
 	Cleanup(func())
@@ -5,7 +5,9 @@ import (
 
 	"github.com/jordyf15/tweeter-api/follow"
 	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type followRepository struct {
@@ -16,16 +18,200 @@ func NewFollowRepo(db *gorm.DB) follow.Repository {
 	return &followRepository{DB: db}
 }
 
-func (repo *followRepository) Create(followerID, followingID string) error {
+// Create inserts the follow unless it already exists. The returned bool is false when it already existed.
+func (repo *followRepository) Create(followerID, followingID string) (bool, error) {
 	follow := &models.Follow{
 		FollowerID:  followerID,
 		FollowingID: followingID,
 		CreatedAt:   time.Now(),
 	}
 
-	return repo.DB.Create(follow).Error
+	result := repo.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(follow)
+	return result.RowsAffected > 0, result.Error
 }
 
-func (repo *followRepository) Delete(followerID, followingID string) error {
-	return repo.DB.Where("follower_id = ? AND following_id = ?", followerID, followingID).Delete(&models.Follow{}).Error
+// Delete removes the follow if it exists. The returned bool is false when there was nothing to remove.
+func (repo *followRepository) Delete(followerID, followingID string) (bool, error) {
+	result := repo.DB.Where("follower_id = ? AND following_id = ?", followerID, followingID).Delete(&models.Follow{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (repo *followRepository) IsFollowing(followerID, followingID string) (bool, error) {
+	var count int64
+	err := repo.DB.Table("follows").Where("follower_id = ? AND following_id = ?", followerID, followingID).Count(&count).Error
+	return count > 0, err
+}
+
+func (repo *followRepository) GetFollowerIDs(followingID string) ([]string, error) {
+	followerIDs := make([]string, 0)
+	err := repo.DB.Table("follows").Where("following_id = ?", followingID).Pluck("follower_id", &followerIDs).Error
+	return followerIDs, err
+}
+
+func (repo *followRepository) GetFollowingIDsWithMinFollowerCount(followerID string, minFollowerCount uint) ([]string, error) {
+	followingIDs := make([]string, 0)
+	err := repo.DB.Table("follows").
+		Joins("JOIN users ON users.id = follows.following_id").
+		Where("follows.follower_id = ? AND users.follower_count >= ?", followerID, minFollowerCount).
+		Pluck("follows.following_id", &followingIDs).Error
+	return followingIDs, err
+}
+
+// GetFollowingIDsAmong returns the IDs in userIDs that are followed by the follower.
+func (repo *followRepository) GetFollowingIDsAmong(followerID string, userIDs []string) ([]string, error) {
+	followingIDs := make([]string, 0)
+	if len(userIDs) == 0 {
+		return followingIDs, nil
+	}
+
+	err := repo.DB.Table("follows").Where("follower_id = ? AND following_id IN ?", followerID, userIDs).Pluck("following_id", &followingIDs).Error
+	return followingIDs, err
+}
+
+// GetFollowerIDsAmong returns the IDs in userIDs that follow the given user.
+func (repo *followRepository) GetFollowerIDsAmong(followingID string, userIDs []string) ([]string, error) {
+	followerIDs := make([]string, 0)
+	if len(userIDs) == 0 {
+		return followerIDs, nil
+	}
+
+	err := repo.DB.Table("follows").Where("following_id = ? AND follower_id IN ?", followingID, userIDs).Pluck("follower_id", &followerIDs).Error
+	return followerIDs, err
+}
+
+func (repo *followRepository) GetFollowers(followingID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error) {
+	follows := make([]*models.Follow, 0)
+
+	query := repo.DB.Table("follows").Where("following_id = ?", followingID)
+	if cursor != nil {
+		query = query.Where("(created_at, follower_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, follower_id DESC").Limit(limit).Find(&follows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+func (repo *followRepository) GetFollowings(followerID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error) {
+	follows := make([]*models.Follow, 0)
+
+	query := repo.DB.Table("follows").Where("follower_id = ?", followerID)
+	if cursor != nil {
+		query = query.Where("(created_at, following_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, following_id DESC").Limit(limit).Find(&follows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+// GetFollowersFollowedBy returns the followers of the user that the viewer also follows.
+func (repo *followRepository) GetFollowersFollowedBy(followingID, viewerID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error) {
+	follows := make([]*models.Follow, 0)
+
+	query := repo.DB.Table("follows").
+		Joins("JOIN follows AS viewer_follows ON viewer_follows.following_id = follows.follower_id AND viewer_follows.follower_id = ?", viewerID).
+		Where("follows.following_id = ?", followingID)
+	if cursor != nil {
+		query = query.Where("(follows.created_at, follows.follower_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Select("follows.*").Order("follows.created_at DESC, follows.follower_id DESC").Limit(limit).Find(&follows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+// CreateRequest inserts a pending follow request unless it already exists. The returned bool is false when it already existed.
+func (repo *followRepository) CreateRequest(followerID, followingID string) (bool, error) {
+	request := &models.Follow{
+		FollowerID:  followerID,
+		FollowingID: followingID,
+		CreatedAt:   time.Now(),
+	}
+
+	result := repo.DB.Table("follow_requests").Clauses(clause.OnConflict{DoNothing: true}).Create(request)
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteRequest removes the pending follow request if it exists. The returned bool is false when there was nothing to remove.
+func (repo *followRepository) DeleteRequest(followerID, followingID string) (bool, error) {
+	result := repo.DB.Table("follow_requests").Where("follower_id = ? AND following_id = ?", followerID, followingID).Delete(&models.Follow{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (repo *followRepository) IsRequested(followerID, followingID string) (bool, error) {
+	var count int64
+	err := repo.DB.Table("follow_requests").Where("follower_id = ? AND following_id = ?", followerID, followingID).Count(&count).Error
+	return count > 0, err
+}
+
+// GetRequests returns the pending follow requests sent to the user, newest first.
+func (repo *followRepository) GetRequests(followingID string, cursor *utils.Cursor, limit int) ([]*models.Follow, error) {
+	requests := make([]*models.Follow, 0)
+
+	query := repo.DB.Table("follow_requests").Where("following_id = ?", followingID)
+	if cursor != nil {
+		query = query.Where("(created_at, follower_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, follower_id DESC").Limit(limit).Find(&requests).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// ApproveRequest turns the pending follow request into a follow. The returned bool is false when there was no request to approve.
+func (repo *followRepository) ApproveRequest(followerID, followingID string) (bool, error) {
+	isApproved := false
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Table("follow_requests").Where("follower_id = ? AND following_id = ?", followerID, followingID).Delete(&models.Follow{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		follow := &models.Follow{
+			FollowerID:  followerID,
+			FollowingID: followingID,
+			CreatedAt:   time.Now(),
+		}
+
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(follow).Error
+		if err != nil {
+			return err
+		}
+
+		isApproved = true
+		return nil
+	})
+
+	return isApproved, err
+}
+
+// GetProtectedIDsNotFollowedAmong returns the IDs in userIDs of protected users, other than the viewer, that the viewer does not follow.
+func (repo *followRepository) GetProtectedIDsNotFollowedAmong(viewerID string, userIDs []string) ([]string, error) {
+	protectedIDs := make([]string, 0)
+	if len(userIDs) == 0 {
+		return protectedIDs, nil
+	}
+
+	err := repo.DB.Table("users").
+		Where("id IN ? AND id <> ? AND is_protected", userIDs, viewerID).
+		Where("NOT EXISTS (SELECT 1 FROM follows WHERE follows.follower_id = ? AND follows.following_id = users.id)", viewerID).
+		Pluck("id", &protectedIDs).Error
+	return protectedIDs, err
 }
@@ -1,26 +1,220 @@
 package usecase
 
 import (
+	"github.com/jordyf15/tweeter-api/block"
 	"github.com/jordyf15/tweeter-api/custom_errors"
 	"github.com/jordyf15/tweeter-api/follow"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/timeline"
 	"github.com/jordyf15/tweeter-api/user"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
 )
 
 type followUsecase struct {
-	followRepo follow.Repository
-	userRepo   user.Repository
+	followRepo   follow.Repository
+	userRepo     user.Repository
+	blockRepo    block.Repository
+	timelineRepo timeline.Repository
+	storage      storage.Storage
 }
 
-func NewFollowUsecase(followRepo follow.Repository, userRepo user.Repository) follow.Usecase {
-	return &followUsecase{followRepo: followRepo, userRepo: userRepo}
+func NewFollowUsecase(followRepo follow.Repository, userRepo user.Repository, blockRepo block.Repository, timelineRepo timeline.Repository, storage storage.Storage) follow.Usecase {
+	return &followUsecase{followRepo: followRepo, userRepo: userRepo, blockRepo: blockRepo, timelineRepo: timelineRepo, storage: storage}
 }
 
-func (usecase *followUsecase) FollowUser(followerID, followingID string) error {
+// FollowUser makes the follower follow the user, or sends them a follow request when the user is
+// protected. Following a user that is already followed or requested leaves the relationship
+// untouched and is not an error. Users cannot follow each other while either of them has blocked
+// the other.
+func (usecase *followUsecase) FollowUser(followerID, followingID string) (*models.Relationship, error) {
 	if followerID == followingID {
-		return custom_errors.ErrMatchedFollowerIDAndFollowingID
+		return nil, custom_errors.ErrMatchedFollowerIDAndFollowingID
 	}
 
-	isExist, err := usecase.userRepo.IsIDExist(followingID)
+	followedUser, err := usecase.userRepo.GetByID(followingID)
+	if err == gorm.ErrRecordNotFound {
+		return nil, custom_errors.ErrRecordNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	isBlocked, err := usecase.blockRepo.IsBlockedBetween(followerID, followingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isBlocked {
+		return nil, custom_errors.ErrFollowBlocked
+	}
+
+	if followedUser.IsProtected {
+		isFollowing, err := usecase.followRepo.IsFollowing(followerID, followingID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isFollowing {
+			_, err = usecase.followRepo.CreateRequest(followerID, followingID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return usecase.getRelationship(followerID, followingID)
+	}
+
+	isCreated, err := usecase.followRepo.Create(followerID, followingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isCreated {
+		err = usecase.timelineRepo.DeleteHomeTimeline(followerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return usecase.getRelationship(followerID, followingID)
+}
+
+// UnfollowUser makes the follower stop following the user and cancels any pending follow request
+// to them. Unfollowing a user that is not followed leaves the relationship untouched and is not
+// an error.
+func (usecase *followUsecase) UnfollowUser(followerID, followingID string) (*models.Relationship, error) {
+	if followerID == followingID {
+		return nil, custom_errors.ErrMatchedFollowerIDAndFollowingID
+	}
+
+	err := usecase.verifyUserExist(followingID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = usecase.followRepo.DeleteRequest(followerID, followingID)
+	if err != nil {
+		return nil, err
+	}
+
+	isDeleted, err := usecase.followRepo.Delete(followerID, followingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isDeleted {
+		err = usecase.timelineRepo.DeleteHomeTimeline(followerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return usecase.getRelationship(followerID, followingID)
+}
+
+func (usecase *followUsecase) GetFollowers(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error) {
+	err := usecase.verifyUserExist(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	follows, err := usecase.followRepo.GetFollowers(userID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usecase.paginateProfiles(viewerID, follows, limit, func(_follow *models.Follow) string { return _follow.FollowerID })
+}
+
+func (usecase *followUsecase) GetFollowings(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error) {
+	err := usecase.verifyUserExist(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	follows, err := usecase.followRepo.GetFollowings(userID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usecase.paginateProfiles(viewerID, follows, limit, func(_follow *models.Follow) string { return _follow.FollowingID })
+}
+
+func (usecase *followUsecase) GetFollowersYouKnow(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error) {
+	err := usecase.verifyUserExist(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	follows, err := usecase.followRepo.GetFollowersFollowedBy(userID, viewerID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usecase.paginateProfiles(viewerID, follows, limit, func(_follow *models.Follow) string { return _follow.FollowerID })
+}
+
+// GetFollowRequests returns the users waiting for the user to approve their follow request.
+func (usecase *followUsecase) GetFollowRequests(userID string, cursor *utils.Cursor, limit int) ([]*models.UserProfile, *utils.Cursor, error) {
+	requests, err := usecase.followRepo.GetRequests(userID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usecase.paginateProfiles(userID, requests, limit, func(request *models.Follow) string { return request.FollowerID })
+}
+
+func (usecase *followUsecase) ApproveFollowRequest(userID, requesterID string) error {
+	isApproved, err := usecase.followRepo.ApproveRequest(requesterID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !isApproved {
+		return custom_errors.ErrFollowRequestNotFound
+	}
+
+	return usecase.timelineRepo.DeleteHomeTimeline(requesterID)
+}
+
+func (usecase *followUsecase) DenyFollowRequest(userID, requesterID string) error {
+	isDeleted, err := usecase.followRepo.DeleteRequest(requesterID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !isDeleted {
+		return custom_errors.ErrFollowRequestNotFound
+	}
+
+	return nil
+}
+
+func (usecase *followUsecase) getRelationship(viewerID, userID string) (*models.Relationship, error) {
+	isFollowing, err := usecase.followRepo.IsFollowing(viewerID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	isFollowRequested := false
+	if !isFollowing {
+		isFollowRequested, err = usecase.followRepo.IsRequested(viewerID, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	followsYou, err := usecase.followRepo.IsFollowing(userID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Relationship{IsFollowing: isFollowing, IsFollowRequested: isFollowRequested, FollowsYou: followsYou}, nil
+}
+
+func (usecase *followUsecase) verifyUserExist(userID string) error {
+	isExist, err := usecase.userRepo.IsIDExist(userID)
 	if err != nil {
 		return err
 	}
@@ -29,13 +223,67 @@ func (usecase *followUsecase) FollowUser(followerID, followingID string) error {
 		return custom_errors.ErrRecordNotFound
 	}
 
-	return usecase.followRepo.Create(followerID, followingID)
+	return nil
 }
 
-func (usecase *followUsecase) UnfollowUser(followerID, followingID string) error {
-	if followerID == followingID {
-		return custom_errors.ErrMatchedFollowerIDAndFollowingID
+// paginateProfiles trims the follows fetched with limit+1 to a page and returns the profiles of
+// the users picked by listedUserID, in the same order, as seen by the viewer.
+func (usecase *followUsecase) paginateProfiles(viewerID string, follows []*models.Follow, limit int, listedUserID func(_follow *models.Follow) string) ([]*models.UserProfile, *utils.Cursor, error) {
+	var nextCursor *utils.Cursor
+	if len(follows) > limit {
+		follows = follows[:limit]
+		lastFollow := follows[limit-1]
+		nextCursor = utils.NewCursor(lastFollow.CreatedAt, listedUserID(lastFollow))
+	}
+
+	userIDs := make([]string, 0, len(follows))
+	for _, _follow := range follows {
+		userIDs = append(userIDs, listedUserID(_follow))
+	}
+
+	profiles, err := usecase.getProfiles(viewerID, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return profiles, nextCursor, nil
+}
+
+func (usecase *followUsecase) getProfiles(viewerID string, userIDs []string) ([]*models.UserProfile, error) {
+	usersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	followingIDs, err := usecase.followRepo.GetFollowingIDsAmong(viewerID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	followerIDs, err := usecase.followRepo.GetFollowerIDsAmong(viewerID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	isFollowing := make(map[string]bool, len(followingIDs))
+	for _, followingID := range followingIDs {
+		isFollowing[followingID] = true
+	}
+
+	followsViewer := make(map[string]bool, len(followerIDs))
+	for _, followerID := range followerIDs {
+		followsViewer[followerID] = true
+	}
+
+	profiles := make([]*models.UserProfile, 0, len(userIDs))
+	for _, userID := range userIDs {
+		_user, isExist := usersByID[userID]
+		if !isExist {
+			continue
+		}
+
+		profiles = append(profiles, &models.UserProfile{User: _user, IsFollowing: isFollowing[userID], FollowsYou: followsViewer[userID]})
 	}
 
-	return usecase.followRepo.Delete(followerID, followingID)
+	return profiles, nil
 }
@@ -2,15 +2,22 @@ package usecase_test
 
 import (
 	"testing"
+	"time"
 
+	blockMocks "github.com/jordyf15/tweeter-api/block/mocks"
 	"github.com/jordyf15/tweeter-api/custom_errors"
 	"github.com/jordyf15/tweeter-api/follow"
 	followMocks "github.com/jordyf15/tweeter-api/follow/mocks"
 	"github.com/jordyf15/tweeter-api/follow/usecase"
+	"github.com/jordyf15/tweeter-api/models"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	timelineMocks "github.com/jordyf15/tweeter-api/timeline/mocks"
 	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 func TestFollowUsecase(t *testing.T) {
@@ -19,68 +26,356 @@ func TestFollowUsecase(t *testing.T) {
 
 type followUsecaseSuite struct {
 	suite.Suite
-	usecase    follow.Usecase
-	userRepo   *userMocks.Repository
-	followRepo *followMocks.Repository
+	usecase      follow.Usecase
+	userRepo     *userMocks.Repository
+	followRepo   *followMocks.Repository
+	blockRepo    *blockMocks.Repository
+	timelineRepo *timelineMocks.Repository
+	storageMock  *storageMocks.Storage
 }
 
+var (
+	futFollows = []*models.Follow{
+		{FollowerID: "userID4", FollowingID: "userID2", CreatedAt: time.Now()},
+		{FollowerID: "userID1", FollowingID: "userID2", CreatedAt: time.Now().Add(-time.Minute)},
+		{FollowerID: "userID5", FollowingID: "userID2", CreatedAt: time.Now().Add(-time.Hour)},
+	}
+)
+
 func (s *followUsecaseSuite) SetupTest() {
 	s.userRepo = new(userMocks.Repository)
 	s.followRepo = new(followMocks.Repository)
+	s.blockRepo = new(blockMocks.Repository)
+	s.timelineRepo = new(timelineMocks.Repository)
+	s.storageMock = new(storageMocks.Storage)
+
+	// follows holds the existing follows as "followerID:followingID"
+	follows := map[string]bool{"userID1:userID4": true, "userID2:userID1": true}
+
+	// requests holds the pending follow requests as "followerID:followingID"
+	requests := map[string]bool{"userID2:userID7": true}
 
 	isIdExist := func(userID string) bool {
 		return userID != "userID3"
 	}
 
+	// userID7 is protected
+	getUserByID := func(userID string) *models.User {
+		if userID == "userID3" {
+			return nil
+		}
+
+		return &models.User{ID: userID, IsProtected: userID == "userID7"}
+	}
+
+	getUserByIDErr := func(userID string) error {
+		if userID == "userID3" {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	}
+
+	createFollow := func(followerID, followingID string) bool {
+		key := followerID + ":" + followingID
+		isExist := follows[key]
+		follows[key] = true
+		return !isExist
+	}
+
+	deleteFollow := func(followerID, followingID string) bool {
+		key := followerID + ":" + followingID
+		isExist := follows[key]
+		delete(follows, key)
+		return isExist
+	}
+
+	// userID6 has blocked userID1
+	isBlockedBetween := func(userID, otherUserID string) bool {
+		return (userID == "userID6" && otherUserID == "userID1") || (userID == "userID1" && otherUserID == "userID6")
+	}
+
+	isFollowing := func(followerID, followingID string) bool {
+		return follows[followerID+":"+followingID]
+	}
+
+	createRequest := func(followerID, followingID string) bool {
+		key := followerID + ":" + followingID
+		isExist := requests[key]
+		requests[key] = true
+		return !isExist
+	}
+
+	deleteRequest := func(followerID, followingID string) bool {
+		key := followerID + ":" + followingID
+		isExist := requests[key]
+		delete(requests, key)
+		return isExist
+	}
+
+	isRequested := func(followerID, followingID string) bool {
+		return requests[followerID+":"+followingID]
+	}
+
+	approveRequest := func(followerID, followingID string) bool {
+		key := followerID + ":" + followingID
+		isExist := requests[key]
+		if isExist {
+			delete(requests, key)
+			follows[key] = true
+		}
+
+		return isExist
+	}
+
+	getFollows := func(userID string, cursor *utils.Cursor, limit int) []*models.Follow {
+		if limit > len(futFollows) {
+			return futFollows
+		}
+
+		return futFollows[:limit]
+	}
+
+	getUsersByIDs := func(ids []string) []*models.User {
+		users := make([]*models.User, 0)
+		for _, id := range ids {
+			// userID5 has deleted their account
+			if id != "userID5" {
+				users = append(users, &models.User{ID: id, Email: id + "@mail.com"})
+			}
+		}
+
+		return users
+	}
+
 	s.userRepo.On("IsIDExist", mock.AnythingOfType("string")).Return(isIdExist, nil)
-	s.followRepo.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
-	s.followRepo.On("Delete", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	s.userRepo.On("GetByID", mock.AnythingOfType("string")).Return(getUserByID, getUserByIDErr)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getUsersByIDs, nil)
+	s.followRepo.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(createFollow, nil)
+	s.followRepo.On("Delete", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(deleteFollow, nil)
+	s.followRepo.On("IsFollowing", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isFollowing, nil)
+	s.followRepo.On("GetFollowers", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(getFollows, nil)
+	s.followRepo.On("GetFollowings", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.Follow{{FollowerID: "userID2", FollowingID: "userID4"}}, nil)
+	s.followRepo.On("GetFollowersFollowedBy", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(futFollows[:1], nil)
+	s.followRepo.On("GetFollowingIDsAmong", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return([]string{"userID4"}, nil)
+	s.followRepo.On("GetFollowerIDsAmong", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return([]string{}, nil)
+	s.followRepo.On("CreateRequest", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(createRequest, nil)
+	s.followRepo.On("DeleteRequest", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(deleteRequest, nil)
+	s.followRepo.On("IsRequested", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isRequested, nil)
+	s.followRepo.On("ApproveRequest", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(approveRequest, nil)
+	s.followRepo.On("GetRequests", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.Follow{{FollowerID: "userID2", FollowingID: "userID7"}}, nil)
+	s.blockRepo.On("IsBlockedBetween", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isBlockedBetween, nil)
+	s.timelineRepo.On("DeleteHomeTimeline", mock.AnythingOfType("string")).Return(nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
 
-	s.usecase = usecase.NewFollowUsecase(s.followRepo, s.userRepo)
+	s.usecase = usecase.NewFollowUsecase(s.followRepo, s.userRepo, s.blockRepo, s.timelineRepo, s.storageMock)
 }
 
 func (s *followUsecaseSuite) TestFollowUserMatchedFollowerIDAndFollowingID() {
-	err := s.usecase.FollowUser("userID1", "userID1")
+	relationship, err := s.usecase.FollowUser("userID1", "userID1")
 
 	assert.Error(s.T(), err)
+	assert.Nil(s.T(), relationship)
 	assert.Equal(s.T(), custom_errors.ErrMatchedFollowerIDAndFollowingID.Error(), err.Error())
 
-	s.userRepo.AssertNumberOfCalls(s.T(), "IsIDExist", 0)
+	s.userRepo.AssertNumberOfCalls(s.T(), "GetByID", 0)
 	s.followRepo.AssertNumberOfCalls(s.T(), "Create", 0)
 }
 
 func (s *followUsecaseSuite) TestFollowUserFollowingIDNotExist() {
-	err := s.usecase.FollowUser("userID1", "userID3")
+	relationship, err := s.usecase.FollowUser("userID1", "userID3")
 
 	assert.Error(s.T(), err)
+	assert.Nil(s.T(), relationship)
 	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
 
-	s.userRepo.AssertNumberOfCalls(s.T(), "IsIDExist", 1)
+	s.userRepo.AssertNumberOfCalls(s.T(), "GetByID", 1)
+	s.followRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *followUsecaseSuite) TestFollowUserBlocked() {
+	relationship, err := s.usecase.FollowUser("userID1", "userID6")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), relationship)
+	assert.Equal(s.T(), custom_errors.ErrFollowBlocked.Error(), err.Error())
+
 	s.followRepo.AssertNumberOfCalls(s.T(), "Create", 0)
 }
 
+func (s *followUsecaseSuite) TestFollowUserAlreadyFollowing() {
+	relationship, err := s.usecase.FollowUser("userID1", "userID4")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.Relationship{IsFollowing: true, FollowsYou: false}, relationship)
+
+	s.followRepo.AssertNumberOfCalls(s.T(), "Create", 1)
+	s.timelineRepo.AssertNumberOfCalls(s.T(), "DeleteHomeTimeline", 0)
+}
+
 func (s *followUsecaseSuite) TestFollowUserSuccessful() {
-	err := s.usecase.FollowUser("userID1", "userID2")
+	relationship, err := s.usecase.FollowUser("userID1", "userID2")
 
 	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.Relationship{IsFollowing: true, FollowsYou: true}, relationship)
 
-	s.userRepo.AssertNumberOfCalls(s.T(), "IsIDExist", 1)
+	s.userRepo.AssertNumberOfCalls(s.T(), "GetByID", 1)
 	s.followRepo.AssertNumberOfCalls(s.T(), "Create", 1)
+	s.timelineRepo.AssertCalled(s.T(), "DeleteHomeTimeline", "userID1")
+}
+
+func (s *followUsecaseSuite) TestFollowUserProtected() {
+	relationship, err := s.usecase.FollowUser("userID1", "userID7")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.Relationship{IsFollowing: false, IsFollowRequested: true, FollowsYou: false}, relationship)
+
+	s.followRepo.AssertCalled(s.T(), "CreateRequest", "userID1", "userID7")
+	s.followRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+	s.timelineRepo.AssertNumberOfCalls(s.T(), "DeleteHomeTimeline", 0)
 }
 
 func (s *followUsecaseSuite) TestUnfollowUserMatchedFollowerIDAndFollowingID() {
-	err := s.usecase.UnfollowUser("userID1", "userID1")
+	relationship, err := s.usecase.UnfollowUser("userID1", "userID1")
 
 	assert.Error(s.T(), err)
+	assert.Nil(s.T(), relationship)
 	assert.Equal(s.T(), custom_errors.ErrMatchedFollowerIDAndFollowingID.Error(), err.Error())
 
 	s.followRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
 }
 
+func (s *followUsecaseSuite) TestUnfollowUserFollowingIDNotExist() {
+	relationship, err := s.usecase.UnfollowUser("userID1", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), relationship)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+
+	s.followRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *followUsecaseSuite) TestUnfollowUserNotFollowing() {
+	relationship, err := s.usecase.UnfollowUser("userID1", "userID2")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.Relationship{IsFollowing: false, FollowsYou: true}, relationship)
+
+	s.followRepo.AssertNumberOfCalls(s.T(), "Delete", 1)
+	s.timelineRepo.AssertNumberOfCalls(s.T(), "DeleteHomeTimeline", 0)
+}
+
+func (s *followUsecaseSuite) TestUnfollowUserCancelsFollowRequest() {
+	relationship, err := s.usecase.UnfollowUser("userID2", "userID7")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.Relationship{IsFollowing: false, IsFollowRequested: false, FollowsYou: false}, relationship)
+
+	s.followRepo.AssertCalled(s.T(), "DeleteRequest", "userID2", "userID7")
+}
+
 func (s *followUsecaseSuite) TestUnfollowUserSuccessful() {
-	err := s.usecase.UnfollowUser("userID1", "userID2")
+	relationship, err := s.usecase.UnfollowUser("userID1", "userID4")
 
 	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.Relationship{IsFollowing: false, FollowsYou: false}, relationship)
 
 	s.followRepo.AssertNumberOfCalls(s.T(), "Delete", 1)
+	s.timelineRepo.AssertCalled(s.T(), "DeleteHomeTimeline", "userID1")
+}
+
+func (s *followUsecaseSuite) TestGetFollowersUserNotFound() {
+	users, _, err := s.usecase.GetFollowers("userID1", "userID3", nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), users)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+	s.followRepo.AssertNumberOfCalls(s.T(), "GetFollowers", 0)
+}
+
+func (s *followUsecaseSuite) TestGetFollowersWithNextPage() {
+	users, nextCursor, err := s.usecase.GetFollowers("userID1", "userID2", nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), "userID1", nextCursor.ID)
+	assert.Equal(s.T(), futFollows[1].CreatedAt, nextCursor.CreatedAt)
+	s.followRepo.AssertCalled(s.T(), "GetFollowers", "userID2", mock.Anything, 3)
+	s.followRepo.AssertCalled(s.T(), "GetFollowingIDsAmong", "userID1", []string{"userID4", "userID1"})
+
+	assert.Len(s.T(), users, 2)
+	assert.Equal(s.T(), "userID4", users[0].ID)
+	assert.True(s.T(), users[0].IsFollowing)
+	assert.Empty(s.T(), users[0].Email)
+	assert.Equal(s.T(), "userID1", users[1].ID)
+	assert.False(s.T(), users[1].IsFollowing)
+	assert.Empty(s.T(), users[1].Email)
+}
+
+func (s *followUsecaseSuite) TestGetFollowersLastPage() {
+	users, nextCursor, err := s.usecase.GetFollowers("userID1", "userID2", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	assert.Len(s.T(), users, 2)
+}
+
+func (s *followUsecaseSuite) TestGetFollowingsSuccessful() {
+	users, nextCursor, err := s.usecase.GetFollowings("userID1", "userID2", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	assert.Len(s.T(), users, 1)
+	assert.Equal(s.T(), "userID4", users[0].ID)
+	s.followRepo.AssertCalled(s.T(), "GetFollowings", "userID2", mock.Anything, 21)
+}
+
+func (s *followUsecaseSuite) TestGetFollowersYouKnowSuccessful() {
+	users, _, err := s.usecase.GetFollowersYouKnow("userID1", "userID2", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), users, 1)
+	assert.Equal(s.T(), "userID4", users[0].ID)
+	s.followRepo.AssertCalled(s.T(), "GetFollowersFollowedBy", "userID2", "userID1", mock.Anything, 21)
+}
+
+func (s *followUsecaseSuite) TestGetFollowRequestsSuccessful() {
+	users, nextCursor, err := s.usecase.GetFollowRequests("userID7", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	assert.Len(s.T(), users, 1)
+	assert.Equal(s.T(), "userID2", users[0].ID)
+	s.followRepo.AssertCalled(s.T(), "GetRequests", "userID7", mock.Anything, 21)
+}
+
+func (s *followUsecaseSuite) TestApproveFollowRequestNotFound() {
+	err := s.usecase.ApproveFollowRequest("userID7", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrFollowRequestNotFound.Error(), err.Error())
+	s.timelineRepo.AssertNumberOfCalls(s.T(), "DeleteHomeTimeline", 0)
+}
+
+func (s *followUsecaseSuite) TestApproveFollowRequestSuccessful() {
+	err := s.usecase.ApproveFollowRequest("userID7", "userID2")
+
+	assert.NoError(s.T(), err)
+	s.followRepo.AssertCalled(s.T(), "ApproveRequest", "userID2", "userID7")
+	s.timelineRepo.AssertCalled(s.T(), "DeleteHomeTimeline", "userID2")
+}
+
+func (s *followUsecaseSuite) TestDenyFollowRequestNotFound() {
+	err := s.usecase.DenyFollowRequest("userID7", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrFollowRequestNotFound.Error(), err.Error())
+}
+
+func (s *followUsecaseSuite) TestDenyFollowRequestSuccessful() {
+	err := s.usecase.DenyFollowRequest("userID7", "userID2")
+
+	assert.NoError(s.T(), err)
+	s.followRepo.AssertCalled(s.T(), "DeleteRequest", "userID2", "userID7")
+	s.followRepo.AssertNumberOfCalls(s.T(), "ApproveRequest", 0)
 }
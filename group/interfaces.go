@@ -1,6 +1,8 @@
 package group
 
 import (
+	"time"
+
 	"github.com/jordyf15/tweeter-api/models"
 	"github.com/jordyf15/tweeter-api/utils"
 )
@@ -9,13 +11,32 @@ var (
 	ThumbnailPictureRes = uint(400)
 	BannerPictureWidth  = uint(900)
 	BannerPictureHeight = uint(350)
+
+	InvitationExpiration = 7 * 24 * time.Hour
 )
 
 type Usecase interface {
 	Create(group *models.Group, groupImage utils.NamedFileReader) (*models.Group, error)
+	Get(groupID string) (*models.Group, error)
+	Edit(groupID string, updates map[string]string, groupImage utils.NamedFileReader) (*models.Group, error)
+	GetGroups(name string, sort models.GroupSort, cursor *utils.Cursor, limit int) ([]*models.Group, *utils.Cursor, error)
+	GetUserGroups(userID string, cursor *utils.Cursor, limit int) ([]*models.Group, *utils.Cursor, error)
+	JoinGroup(groupID, userID string) (*models.GroupJoinRequest, error)
+	LeaveGroup(groupID, userID string) error
+	GetJoinRequests(groupID string, cursor *utils.Cursor, limit int) ([]*models.GroupJoinRequest, *utils.Cursor, error)
+	ApproveJoinRequest(groupID, requestID, approverID string) error
+	RejectJoinRequest(groupID, requestID string) error
+	InviteUser(groupID, inviterID, inviteeID, inviteeUsername string) (*models.GroupInvitation, error)
+	GetInvitations(userID string, cursor *utils.Cursor, limit int) ([]*models.GroupInvitation, *utils.Cursor, error)
+	AcceptInvitation(groupID, invitationID, userID string) error
+	DeclineInvitation(groupID, invitationID, userID string) error
 }
 
 type Repository interface {
 	Create(group *models.Group) error
+	Update(group *models.Group) error
 	CreateTransaction(fn func(repo Repository) error) error
+	GetByID(id string) (*models.Group, error)
+	GetByIDs(ids []string) ([]*models.Group, error)
+	Search(name string, sort models.GroupSort, cursor *utils.Cursor, limit int) ([]*models.Group, error)
 }
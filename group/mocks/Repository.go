@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/jordyf15/tweeter-api/models"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -42,6 +44,89 @@ func (_m *Repository) CreateTransaction(fn func(group.Repository) error) error {
 	return r0
 }
 
+// GetByID provides a mock function with given fields: id
+func (_m *Repository) GetByID(id string) (*models.Group, error) {
+	ret := _m.Called(id)
+
+	var r0 *models.Group
+	if rf, ok := ret.Get(0).(func(string) *models.Group); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDs provides a mock function with given fields: ids
+func (_m *Repository) GetByIDs(ids []string) ([]*models.Group, error) {
+	ret := _m.Called(ids)
+
+	var r0 []*models.Group
+	if rf, ok := ret.Get(0).(func([]string) []*models.Group); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: name, sort, cursor, limit
+func (_m *Repository) Search(name string, sort models.GroupSort, cursor *utils.Cursor, limit int) ([]*models.Group, error) {
+	ret := _m.Called(name, sort, cursor, limit)
+
+	var r0 []*models.Group
+	if rf, ok := ret.Get(0).(func(string, models.GroupSort, *utils.Cursor, int) []*models.Group); ok {
+		r0 = rf(name, sort, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, models.GroupSort, *utils.Cursor, int) error); ok {
+		r1 = rf(name, sort, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: _a0
+func (_m *Repository) Update(_a0 *models.Group) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Group) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
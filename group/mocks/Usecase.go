@@ -14,6 +14,34 @@ type Usecase struct {
 	mock.Mock
 }
 
+// AcceptInvitation provides a mock function with given fields: groupID, invitationID, userID
+func (_m *Usecase) AcceptInvitation(groupID string, invitationID string, userID string) error {
+	ret := _m.Called(groupID, invitationID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(groupID, invitationID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApproveJoinRequest provides a mock function with given fields: groupID, requestID, approverID
+func (_m *Usecase) ApproveJoinRequest(groupID string, requestID string, approverID string) error {
+	ret := _m.Called(groupID, requestID, approverID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(groupID, requestID, approverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: _a0, groupImage
 func (_m *Usecase) Create(_a0 *models.Group, groupImage utils.NamedFileReader) (*models.Group, error) {
 	ret := _m.Called(_a0, groupImage)
@@ -37,6 +65,268 @@ func (_m *Usecase) Create(_a0 *models.Group, groupImage utils.NamedFileReader) (
 	return r0, r1
 }
 
+// DeclineInvitation provides a mock function with given fields: groupID, invitationID, userID
+func (_m *Usecase) DeclineInvitation(groupID string, invitationID string, userID string) error {
+	ret := _m.Called(groupID, invitationID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(groupID, invitationID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Edit provides a mock function with given fields: groupID, updates, groupImage
+func (_m *Usecase) Edit(groupID string, updates map[string]string, groupImage utils.NamedFileReader) (*models.Group, error) {
+	ret := _m.Called(groupID, updates, groupImage)
+
+	var r0 *models.Group
+	if rf, ok := ret.Get(0).(func(string, map[string]string, utils.NamedFileReader) *models.Group); ok {
+		r0 = rf(groupID, updates, groupImage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]string, utils.NamedFileReader) error); ok {
+		r1 = rf(groupID, updates, groupImage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: groupID
+func (_m *Usecase) Get(groupID string) (*models.Group, error) {
+	ret := _m.Called(groupID)
+
+	var r0 *models.Group
+	if rf, ok := ret.Get(0).(func(string) *models.Group); ok {
+		r0 = rf(groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGroups provides a mock function with given fields: name, sort, cursor, limit
+func (_m *Usecase) GetGroups(name string, sort models.GroupSort, cursor *utils.Cursor, limit int) ([]*models.Group, *utils.Cursor, error) {
+	ret := _m.Called(name, sort, cursor, limit)
+
+	var r0 []*models.Group
+	if rf, ok := ret.Get(0).(func(string, models.GroupSort, *utils.Cursor, int) []*models.Group); ok {
+		r0 = rf(name, sort, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Group)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, models.GroupSort, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(name, sort, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, models.GroupSort, *utils.Cursor, int) error); ok {
+		r2 = rf(name, sort, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetInvitations provides a mock function with given fields: userID, cursor, limit
+func (_m *Usecase) GetInvitations(userID string, cursor *utils.Cursor, limit int) ([]*models.GroupInvitation, *utils.Cursor, error) {
+	ret := _m.Called(userID, cursor, limit)
+
+	var r0 []*models.GroupInvitation
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.GroupInvitation); ok {
+		r0 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.GroupInvitation)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, *utils.Cursor, int) error); ok {
+		r2 = rf(userID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetJoinRequests provides a mock function with given fields: groupID, cursor, limit
+func (_m *Usecase) GetJoinRequests(groupID string, cursor *utils.Cursor, limit int) ([]*models.GroupJoinRequest, *utils.Cursor, error) {
+	ret := _m.Called(groupID, cursor, limit)
+
+	var r0 []*models.GroupJoinRequest
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.GroupJoinRequest); ok {
+		r0 = rf(groupID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.GroupJoinRequest)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(groupID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, *utils.Cursor, int) error); ok {
+		r2 = rf(groupID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetUserGroups provides a mock function with given fields: userID, cursor, limit
+func (_m *Usecase) GetUserGroups(userID string, cursor *utils.Cursor, limit int) ([]*models.Group, *utils.Cursor, error) {
+	ret := _m.Called(userID, cursor, limit)
+
+	var r0 []*models.Group
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.Group); ok {
+		r0 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Group)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, *utils.Cursor, int) error); ok {
+		r2 = rf(userID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// InviteUser provides a mock function with given fields: groupID, inviterID, inviteeID, inviteeUsername
+func (_m *Usecase) InviteUser(groupID string, inviterID string, inviteeID string, inviteeUsername string) (*models.GroupInvitation, error) {
+	ret := _m.Called(groupID, inviterID, inviteeID, inviteeUsername)
+
+	var r0 *models.GroupInvitation
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.GroupInvitation); ok {
+		r0 = rf(groupID, inviterID, inviteeID, inviteeUsername)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.GroupInvitation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(groupID, inviterID, inviteeID, inviteeUsername)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JoinGroup provides a mock function with given fields: groupID, userID
+func (_m *Usecase) JoinGroup(groupID string, userID string) (*models.GroupJoinRequest, error) {
+	ret := _m.Called(groupID, userID)
+
+	var r0 *models.GroupJoinRequest
+	if rf, ok := ret.Get(0).(func(string, string) *models.GroupJoinRequest); ok {
+		r0 = rf(groupID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.GroupJoinRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(groupID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveGroup provides a mock function with given fields: groupID, userID
+func (_m *Usecase) LeaveGroup(groupID string, userID string) error {
+	ret := _m.Called(groupID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(groupID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RejectJoinRequest provides a mock function with given fields: groupID, requestID
+func (_m *Usecase) RejectJoinRequest(groupID string, requestID string) error {
+	ret := _m.Called(groupID, requestID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(groupID, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewUsecase interface {
 	mock.TestingT
 	Cleanup(func())
@@ -1,11 +1,16 @@
 package repository
 
 import (
+	"strings"
+
 	"github.com/jordyf15/tweeter-api/group"
 	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
 	"gorm.io/gorm"
 )
 
+var likePatternEscaper = strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_")
+
 type groupRepository struct {
 	DB *gorm.DB
 }
@@ -18,8 +23,70 @@ func (repo *groupRepository) Create(group *models.Group) error {
 	return repo.DB.Create(group).Error
 }
 
+// Update saves the editable fields of the group, leaving member_count to the trigger that maintains it.
+func (repo *groupRepository) Update(group *models.Group) error {
+	return repo.DB.Model(group).Select("name", "description", "images", "is_open").Updates(group).Error
+}
+
 func (repo *groupRepository) CreateTransaction(fn func(repo group.Repository) error) error {
 	return repo.DB.Transaction(func(tx *gorm.DB) error {
 		return fn(&groupRepository{DB: tx})
 	})
 }
+
+func (repo *groupRepository) GetByID(id string) (*models.Group, error) {
+	group := &models.Group{}
+
+	err := repo.DB.Table("groups").Where("id = ?", id).First(group).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (repo *groupRepository) GetByIDs(ids []string) ([]*models.Group, error) {
+	groups := make([]*models.Group, 0)
+	if len(ids) == 0 {
+		return groups, nil
+	}
+
+	err := repo.DB.Table("groups").Where("id IN ?", ids).Find(&groups).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// Search returns the groups whose name contains the given name, ignoring case. Sorting by member
+// count resumes from the member count stored in the cursor.
+func (repo *groupRepository) Search(name string, sort models.GroupSort, cursor *utils.Cursor, limit int) ([]*models.Group, error) {
+	groups := make([]*models.Group, 0)
+
+	query := repo.DB.Table("groups")
+	if len(name) > 0 {
+		query = query.Where("name ILIKE ?", "%"+likePatternEscaper.Replace(name)+"%")
+	}
+
+	if sort == models.GroupSortMemberCount {
+		if cursor != nil {
+			query = query.Where("(member_count, created_at, id) < (?, ?, ?)", *cursor.Count, cursor.CreatedAt, cursor.ID)
+		}
+
+		query = query.Order("member_count DESC, created_at DESC, id DESC")
+	} else {
+		if cursor != nil {
+			query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+		}
+
+		query = query.Order("created_at DESC, id DESC")
+	}
+
+	err := query.Limit(limit).Find(&groups).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
@@ -3,27 +3,37 @@ package usecase
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jordyf15/tweeter-api/block"
 	"github.com/jordyf15/tweeter-api/custom_errors"
 	"github.com/jordyf15/tweeter-api/group"
+	"github.com/jordyf15/tweeter-api/group_invitation"
+	"github.com/jordyf15/tweeter-api/group_join_request"
 	"github.com/jordyf15/tweeter-api/group_member"
 	"github.com/jordyf15/tweeter-api/models"
 	"github.com/jordyf15/tweeter-api/storage"
 	"github.com/jordyf15/tweeter-api/user"
 	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
 )
 
 type groupUsecase struct {
-	groupRepo       group.Repository
-	userRepo        user.Repository
-	groupMemberRepo group_member.Repository
-	storage         storage.Storage
+	groupRepo            group.Repository
+	userRepo             user.Repository
+	groupMemberRepo      group_member.Repository
+	groupJoinRequestRepo group_join_request.Repository
+	groupInvitationRepo  group_invitation.Repository
+	blockRepo            block.Repository
+	storage              storage.Storage
 }
 
-func NewGroupUsecase(groupRepo group.Repository, groupMemberRepo group_member.Repository, userRepo user.Repository, storage storage.Storage) group.Usecase {
-	return &groupUsecase{groupRepo: groupRepo, groupMemberRepo: groupMemberRepo, userRepo: userRepo, storage: storage}
+func NewGroupUsecase(groupRepo group.Repository, groupMemberRepo group_member.Repository, groupJoinRequestRepo group_join_request.Repository, groupInvitationRepo group_invitation.Repository, userRepo user.Repository, blockRepo block.Repository, storage storage.Storage) group.Usecase {
+	return &groupUsecase{groupRepo: groupRepo, groupMemberRepo: groupMemberRepo, groupJoinRequestRepo: groupJoinRequestRepo, groupInvitationRepo: groupInvitationRepo, userRepo: userRepo, blockRepo: blockRepo, storage: storage}
 }
 
 func (usecase *groupUsecase) Create(_group *models.Group, groupImageReader utils.NamedFileReader) (*models.Group, error) {
@@ -126,8 +136,593 @@ func (usecase *groupUsecase) Create(_group *models.Group, groupImageReader utils
 		return nil, &custom_errors.MultipleErrors{Errors: errors}
 	}
 
+	usecase.storage.AssignImageURLToUser(_group.Creator)
 	usecase.storage.AssignImageURLToGroup(_group)
 	_group.MemberCount = 1
 
 	return _group, nil
 }
+
+func (usecase *groupUsecase) Get(groupID string) (*models.Group, error) {
+	_group, err := usecase.groupRepo.GetByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = usecase.assignCreators([]*models.Group{_group})
+	if err != nil {
+		return nil, err
+	}
+
+	return _group, nil
+}
+
+// Edit updates the group's name, description, is_open and image. A new image replaces both the thumbnail and the banner.
+func (usecase *groupUsecase) Edit(groupID string, updates map[string]string, groupImageReader utils.NamedFileReader) (*models.Group, error) {
+	errors := make([]error, 0)
+
+	_group, err := usecase.groupRepo.GetByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if newName, isExist := updates["name"]; isExist && newName != _group.Name {
+		_group.Name = newName
+	}
+
+	if newDescription, isExist := updates["description"]; isExist && newDescription != _group.Description {
+		_group.Description = newDescription
+	}
+
+	if newIsOpen, isExist := updates["is_open"]; isExist {
+		isOpen, err := strconv.ParseBool(newIsOpen)
+		if err != nil {
+			errors = append(errors, custom_errors.ErrIsOpenInvalid)
+		}
+
+		_group.IsOpen = isOpen
+	}
+
+	validateFieldErrors := _group.VerifyFields()
+	if len(validateFieldErrors) > 0 {
+		errors = append(errors, validateFieldErrors...)
+	}
+
+	if groupImageReader != nil {
+		switch utils.GetFileExtension(groupImageReader.Name()) {
+		case "jpg", "jpeg", "png":
+			break
+		default:
+			errors = append(errors, custom_errors.ErrGroupImageInvalidFormat)
+		}
+	}
+
+	if len(errors) > 0 {
+		return nil, &custom_errors.MultipleErrors{Errors: errors}
+	}
+
+	previousImages := _group.Images
+	if groupImageReader != nil {
+		_group.Images = []*models.Image{
+			{
+				Filename: utils.RandFileName("", "."+utils.GetFileExtension(groupImageReader.Name())),
+				Width:    group.ThumbnailPictureRes,
+				Height:   group.ThumbnailPictureRes,
+			},
+			{
+				Filename: utils.RandFileName("", "."+utils.GetFileExtension(groupImageReader.Name())),
+				Width:    group.BannerPictureWidth,
+				Height:   group.BannerPictureHeight,
+			},
+		}
+	}
+
+	err = usecase.groupRepo.CreateTransaction(func(repo group.Repository) error {
+		err := repo.Update(_group)
+		if err != nil {
+			return err
+		}
+
+		if groupImageReader == nil {
+			return nil
+		}
+
+		var wg sync.WaitGroup
+		// 1 for saving and 1 for deleting each of the thumbnail and banner
+		fileStorageChannels := make(chan error, len(_group.Images)+len(previousImages))
+		wg.Add(len(_group.Images) + len(previousImages))
+
+		for _, img := range _group.Images {
+			resizedImageFile, err := utils.ResizeImage(groupImageReader, int(img.Width), int(img.Height))
+			if err != nil {
+				return err
+			}
+
+			defer os.Remove(resizedImageFile.Name())
+			go usecase.storage.UploadFile(fileStorageChannels, &wg, resizedImageFile, _group.ImagePath(img), nil)
+		}
+
+		for _, img := range previousImages {
+			go usecase.storage.RemoveFile(fileStorageChannels, &wg, _group.ImagePath(img))
+		}
+
+		wg.Wait()
+		close(fileStorageChannels)
+
+		for err := range fileStorageChannels {
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		switch actualErr := err.(type) {
+		case *custom_errors.MultipleErrors:
+			errors = append(errors, actualErr.Errors...)
+		default:
+			errors = append(errors, err)
+		}
+	}
+
+	if len(errors) > 0 {
+		return nil, &custom_errors.MultipleErrors{Errors: errors}
+	}
+
+	err = usecase.assignCreators([]*models.Group{_group})
+	if err != nil {
+		return nil, err
+	}
+
+	return _group, nil
+}
+
+// GetGroups returns the groups whose name contains the given name, or every group when it is empty.
+func (usecase *groupUsecase) GetGroups(name string, sort models.GroupSort, cursor *utils.Cursor, limit int) ([]*models.Group, *utils.Cursor, error) {
+	if sort != models.GroupSortCreatedAt && sort != models.GroupSortMemberCount {
+		return nil, nil, custom_errors.ErrInvalidGroupSort
+	}
+
+	if sort == models.GroupSortMemberCount && cursor != nil && cursor.Count == nil {
+		return nil, nil, custom_errors.ErrInvalidCursor
+	}
+
+	groups, err := usecase.groupRepo.Search(strings.TrimSpace(name), sort, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(groups) > limit {
+		groups = groups[:limit]
+		lastGroup := groups[limit-1]
+		if sort == models.GroupSortMemberCount {
+			nextCursor = utils.NewCountCursor(lastGroup.MemberCount, lastGroup.CreatedAt, lastGroup.ID)
+		} else {
+			nextCursor = utils.NewCursor(lastGroup.CreatedAt, lastGroup.ID)
+		}
+	}
+
+	err = usecase.assignCreators(groups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, nextCursor, nil
+}
+
+// GetUserGroups returns the groups the user is a member of along with their role, most recently joined first.
+func (usecase *groupUsecase) GetUserGroups(userID string, cursor *utils.Cursor, limit int) ([]*models.Group, *utils.Cursor, error) {
+	isExist, err := usecase.userRepo.IsIDExist(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isExist {
+		return nil, nil, custom_errors.ErrRecordNotFound
+	}
+
+	groupMembers, err := usecase.groupMemberRepo.GetByMemberID(userID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(groupMembers) > limit {
+		groupMembers = groupMembers[:limit]
+		lastGroupMember := groupMembers[limit-1]
+		nextCursor = utils.NewCursor(lastGroupMember.CreatedAt, lastGroupMember.GroupID)
+	}
+
+	groupIDs := make([]string, 0, len(groupMembers))
+	for _, groupMember := range groupMembers {
+		groupIDs = append(groupIDs, groupMember.GroupID)
+	}
+
+	groups, err := usecase.groupRepo.GetByIDs(groupIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupsByID := make(map[string]*models.Group, len(groups))
+	for _, _group := range groups {
+		groupsByID[_group.ID] = _group
+	}
+
+	userGroups := make([]*models.Group, 0, len(groupMembers))
+	for _, groupMember := range groupMembers {
+		_group, isExist := groupsByID[groupMember.GroupID]
+		if !isExist {
+			continue
+		}
+
+		_group.Role = groupMember.Role
+		userGroups = append(userGroups, _group)
+	}
+
+	err = usecase.assignCreators(userGroups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return userGroups, nextCursor, nil
+}
+
+// JoinGroup adds the user to the group as a member when the group is open. For closed groups a join
+// request is created instead and returned.
+func (usecase *groupUsecase) JoinGroup(groupID, userID string) (*models.GroupJoinRequest, error) {
+	_group, err := usecase.groupRepo.GetByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = usecase.groupMemberRepo.Get(groupID, userID)
+	if err == nil {
+		return nil, custom_errors.ErrAlreadyGroupMember
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	if !_group.IsOpen {
+		isRequested, err := usecase.groupJoinRequestRepo.IsRequested(groupID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if isRequested {
+			return nil, custom_errors.ErrGroupJoinRequestAlreadyExist
+		}
+
+		request := &models.GroupJoinRequest{
+			ID:          uuid.New().String(),
+			GroupID:     groupID,
+			RequesterID: userID,
+		}
+
+		err = usecase.groupJoinRequestRepo.Create(request)
+		if err != nil {
+			return nil, err
+		}
+
+		return request, nil
+	}
+
+	groupMember := &models.GroupMember{
+		GroupID:  groupID,
+		MemberID: userID,
+		Role:     models.GroupMemberRoleMember,
+	}
+
+	return nil, usecase.groupMemberRepo.Create(groupMember)
+}
+
+// LeaveGroup removes the user from the group. The last admin of a group has to hand over the role first.
+func (usecase *groupUsecase) LeaveGroup(groupID, userID string) error {
+	_, err := usecase.groupRepo.GetByID(groupID)
+	if err != nil {
+		return err
+	}
+
+	groupMember, err := usecase.groupMemberRepo.Get(groupID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return custom_errors.ErrNotGroupMember
+	} else if err != nil {
+		return err
+	}
+
+	if groupMember.Role != models.GroupMemberRoleAdmin {
+		return usecase.groupMemberRepo.Delete(groupID, userID)
+	}
+
+	isDeleted, err := usecase.groupMemberRepo.DeleteAdminIfNotLast(groupID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !isDeleted {
+		return custom_errors.ErrLastGroupAdmin
+	}
+
+	return nil
+}
+
+// GetJoinRequests returns the pending join requests of the group, newest first.
+func (usecase *groupUsecase) GetJoinRequests(groupID string, cursor *utils.Cursor, limit int) ([]*models.GroupJoinRequest, *utils.Cursor, error) {
+	requests, err := usecase.groupJoinRequestRepo.GetByGroupID(groupID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(requests) > limit {
+		requests = requests[:limit]
+		lastRequest := requests[limit-1]
+		nextCursor = utils.NewCursor(lastRequest.CreatedAt, lastRequest.ID)
+	}
+
+	requesterIDs := make([]string, 0, len(requests))
+	for _, request := range requests {
+		requesterIDs = append(requesterIDs, request.RequesterID)
+	}
+
+	requestersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, requesterIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, request := range requests {
+		request.Requester = requestersByID[request.RequesterID]
+	}
+
+	return requests, nextCursor, nil
+}
+
+// ApproveJoinRequest adds the requester to the group. Requests from users who have blocked the approver
+// or whom the approver has blocked cannot be approved by them.
+func (usecase *groupUsecase) ApproveJoinRequest(groupID, requestID, approverID string) error {
+	request, err := usecase.getJoinRequest(groupID, requestID)
+	if err != nil {
+		return err
+	}
+
+	isBlocked, err := usecase.blockRepo.IsBlockedBetween(approverID, request.RequesterID)
+	if err != nil {
+		return err
+	}
+
+	if isBlocked {
+		return custom_errors.ErrUserBlocked
+	}
+
+	isApproved, err := usecase.groupJoinRequestRepo.Approve(request)
+	if err != nil {
+		return err
+	}
+
+	if !isApproved {
+		return custom_errors.ErrGroupJoinRequestNotFound
+	}
+
+	return nil
+}
+
+func (usecase *groupUsecase) RejectJoinRequest(groupID, requestID string) error {
+	request, err := usecase.getJoinRequest(groupID, requestID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.groupJoinRequestRepo.Delete(request.ID)
+}
+
+// getJoinRequest returns the join request if it was sent to the group.
+func (usecase *groupUsecase) getJoinRequest(groupID, requestID string) (*models.GroupJoinRequest, error) {
+	request, err := usecase.groupJoinRequestRepo.GetByID(requestID)
+	if err == gorm.ErrRecordNotFound || (err == nil && request.GroupID != groupID) {
+		return nil, custom_errors.ErrGroupJoinRequestNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// InviteUser invites the user with the given id, or with the given username when the id is empty, to the group.
+// Users cannot invite a user who has blocked them or whom they have blocked.
+func (usecase *groupUsecase) InviteUser(groupID, inviterID, inviteeID, inviteeUsername string) (*models.GroupInvitation, error) {
+	var invitee *models.User
+	var err error
+	if len(inviteeID) > 0 {
+		invitee, err = usecase.userRepo.GetByID(inviteeID)
+	} else if len(inviteeUsername) > 0 {
+		invitee, err = usecase.userRepo.GetByUsername(inviteeUsername)
+	} else {
+		return nil, custom_errors.ErrGroupInviteeMissing
+	}
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, custom_errors.ErrRecordNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	isBlocked, err := usecase.blockRepo.IsBlockedBetween(inviterID, invitee.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isBlocked {
+		return nil, custom_errors.ErrUserBlocked
+	}
+
+	_, err = usecase.groupMemberRepo.Get(groupID, invitee.ID)
+	if err == nil {
+		return nil, custom_errors.ErrAlreadyGroupMember
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	isInvited, err := usecase.groupInvitationRepo.IsInvited(groupID, invitee.ID, invitationCutoff())
+	if err != nil {
+		return nil, err
+	}
+
+	if isInvited {
+		return nil, custom_errors.ErrGroupInvitationAlreadyExist
+	}
+
+	invitation := &models.GroupInvitation{
+		ID:        uuid.New().String(),
+		GroupID:   groupID,
+		InviterID: inviterID,
+		InviteeID: invitee.ID,
+	}
+
+	err = usecase.groupInvitationRepo.Create(invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.storage.AssignImageURLToUser(invitee)
+	invitation.Invitee = invitee
+	invitation.ExpiresAt = invitation.CreatedAt.Add(group.InvitationExpiration)
+
+	return invitation, nil
+}
+
+// GetInvitations returns the user's invitations that have not expired, newest first.
+func (usecase *groupUsecase) GetInvitations(userID string, cursor *utils.Cursor, limit int) ([]*models.GroupInvitation, *utils.Cursor, error) {
+	invitations, err := usecase.groupInvitationRepo.GetByInviteeID(userID, invitationCutoff(), cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(invitations) > limit {
+		invitations = invitations[:limit]
+		lastInvitation := invitations[limit-1]
+		nextCursor = utils.NewCursor(lastInvitation.CreatedAt, lastInvitation.ID)
+	}
+
+	groupIDs := make([]string, 0, len(invitations))
+	inviterIDs := make([]string, 0, len(invitations))
+	for _, invitation := range invitations {
+		groupIDs = append(groupIDs, invitation.GroupID)
+		inviterIDs = append(inviterIDs, invitation.InviterID)
+	}
+
+	groups, err := usecase.groupRepo.GetByIDs(groupIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = usecase.assignCreators(groups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupsByID := make(map[string]*models.Group, len(groups))
+	for _, _group := range groups {
+		groupsByID[_group.ID] = _group
+	}
+
+	invitersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, inviterIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userInvitations := make([]*models.GroupInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		_group, isExist := groupsByID[invitation.GroupID]
+		if !isExist {
+			continue
+		}
+
+		invitation.Group = _group
+		invitation.Inviter = invitersByID[invitation.InviterID]
+		invitation.ExpiresAt = invitation.CreatedAt.Add(group.InvitationExpiration)
+		userInvitations = append(userInvitations, invitation)
+	}
+
+	return userInvitations, nextCursor, nil
+}
+
+// AcceptInvitation adds the invitee to the group regardless of whether the group is open. Invitations
+// from a user who has since been blocked by or has blocked the invitee cannot be accepted.
+func (usecase *groupUsecase) AcceptInvitation(groupID, invitationID, userID string) error {
+	invitation, err := usecase.getInvitation(groupID, invitationID, userID)
+	if err != nil {
+		return err
+	}
+
+	isBlocked, err := usecase.blockRepo.IsBlockedBetween(invitation.InviterID, userID)
+	if err != nil {
+		return err
+	}
+
+	if isBlocked {
+		return custom_errors.ErrUserBlocked
+	}
+
+	isAccepted, err := usecase.groupInvitationRepo.Accept(invitation)
+	if err != nil {
+		return err
+	}
+
+	if !isAccepted {
+		return custom_errors.ErrGroupInvitationNotFound
+	}
+
+	return nil
+}
+
+func (usecase *groupUsecase) DeclineInvitation(groupID, invitationID, userID string) error {
+	invitation, err := usecase.getInvitation(groupID, invitationID, userID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.groupInvitationRepo.Delete(invitation.ID)
+}
+
+// getInvitation returns the invitation if it was sent to the user for the group and has not expired.
+func (usecase *groupUsecase) getInvitation(groupID, invitationID, userID string) (*models.GroupInvitation, error) {
+	invitation, err := usecase.groupInvitationRepo.GetByID(invitationID)
+	if err == gorm.ErrRecordNotFound {
+		return nil, custom_errors.ErrGroupInvitationNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if invitation.GroupID != groupID || invitation.InviteeID != userID || !invitation.CreatedAt.After(invitationCutoff()) {
+		return nil, custom_errors.ErrGroupInvitationNotFound
+	}
+
+	return invitation, nil
+}
+
+// invitationCutoff returns the creation time before which invitations are expired.
+func invitationCutoff() time.Time {
+	return time.Now().Add(-group.InvitationExpiration)
+}
+
+func (usecase *groupUsecase) assignCreators(groups []*models.Group) error {
+	creatorIDs := make([]string, 0, len(groups))
+	for _, _group := range groups {
+		creatorIDs = append(creatorIDs, _group.CreatorID)
+	}
+
+	creatorsByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, creatorIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, _group := range groups {
+		_group.Creator = creatorsByID[_group.CreatorID]
+		usecase.storage.AssignImageURLToGroup(_group)
+	}
+
+	return nil
+}
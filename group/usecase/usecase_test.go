@@ -3,12 +3,17 @@ package usecase_test
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	blockMocks "github.com/jordyf15/tweeter-api/block/mocks"
 	"github.com/jordyf15/tweeter-api/custom_errors"
 	"github.com/jordyf15/tweeter-api/group"
 	groupMocks "github.com/jordyf15/tweeter-api/group/mocks"
 	"github.com/jordyf15/tweeter-api/group/usecase"
+	groupInvitationMocks "github.com/jordyf15/tweeter-api/group_invitation/mocks"
+	groupJoinRequestMocks "github.com/jordyf15/tweeter-api/group_join_request/mocks"
 	groupMemberMocks "github.com/jordyf15/tweeter-api/group_member/mocks"
 	"github.com/jordyf15/tweeter-api/models"
 	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
@@ -17,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 func TestGroupUsecase(t *testing.T) {
@@ -25,11 +31,14 @@ func TestGroupUsecase(t *testing.T) {
 
 type groupUsecaseSuite struct {
 	suite.Suite
-	usecase         group.Usecase
-	userRepo        *userMocks.Repository
-	groupRepo       *groupMocks.Repository
-	groupMemberRepo *groupMemberMocks.Repository
-	storageMock     *storageMocks.Storage
+	usecase              group.Usecase
+	userRepo             *userMocks.Repository
+	groupRepo            *groupMocks.Repository
+	groupMemberRepo      *groupMemberMocks.Repository
+	groupJoinRequestRepo *groupJoinRequestMocks.Repository
+	groupInvitationRepo  *groupInvitationMocks.Repository
+	blockRepo            *blockMocks.Repository
+	storageMock          *storageMocks.Storage
 }
 
 var (
@@ -38,18 +47,183 @@ var (
 		Description: "vtuber comedian idol group",
 		IsOpen:      true,
 	}
+
+	gutGroups = []*models.Group{
+		{ID: "groupID1", Name: "hololive", CreatorID: "userID1", MemberCount: 3, CreatedAt: time.Now()},
+		{ID: "groupID2", Name: "hololive en", CreatorID: "userID2", MemberCount: 2, CreatedAt: time.Now().Add(-time.Minute)},
+		{ID: "groupID3", Name: "hololive id", CreatorID: "userID1", MemberCount: 1, CreatedAt: time.Now().Add(-time.Hour)},
+	}
+
+	gutGroupMembers = []*models.GroupMember{
+		{GroupID: "groupID2", MemberID: "userID1", Role: models.GroupMemberRoleMember, CreatedAt: time.Now()},
+		{GroupID: "groupID4", MemberID: "userID1", Role: models.GroupMemberRoleMember, CreatedAt: time.Now().Add(-time.Minute)},
+		{GroupID: "groupID1", MemberID: "userID1", Role: models.GroupMemberRoleAdmin, CreatedAt: time.Now().Add(-time.Hour)},
+	}
 )
 
 func (s *groupUsecaseSuite) SetupTest() {
 	s.userRepo = new(userMocks.Repository)
 	s.groupRepo = new(groupMocks.Repository)
 	s.groupMemberRepo = new(groupMemberMocks.Repository)
+	s.groupJoinRequestRepo = new(groupJoinRequestMocks.Repository)
+	s.groupInvitationRepo = new(groupInvitationMocks.Repository)
+	s.blockRepo = new(blockMocks.Repository)
 	s.storageMock = new(storageMocks.Storage)
 
+	searchGroups := func(name string, sort models.GroupSort, cursor *utils.Cursor, limit int) []*models.Group {
+		if limit > len(gutGroups) {
+			return gutGroups
+		}
+
+		return gutGroups[:limit]
+	}
+
+	getGroupsByIDs := func(ids []string) []*models.Group {
+		groups := make([]*models.Group, 0)
+		for _, _group := range gutGroups {
+			for _, id := range ids {
+				if _group.ID == id {
+					groups = append(groups, &models.Group{ID: _group.ID, Name: _group.Name, CreatorID: _group.CreatorID})
+					break
+				}
+			}
+		}
+
+		return groups
+	}
+
+	getGroupMembersByMemberID := func(memberID string, cursor *utils.Cursor, limit int) []*models.GroupMember {
+		if limit > len(gutGroupMembers) {
+			return gutGroupMembers
+		}
+
+		return gutGroupMembers[:limit]
+	}
+
+	groupMembers := map[string]*models.GroupMember{
+		"groupID1:userID1": {GroupID: "groupID1", MemberID: "userID1", Role: models.GroupMemberRoleAdmin},
+		"groupID1:userID4": {GroupID: "groupID1", MemberID: "userID4", Role: models.GroupMemberRoleModerator},
+		"groupID1:userID5": {GroupID: "groupID1", MemberID: "userID5", Role: models.GroupMemberRoleMember},
+		"groupID5:userID1": {GroupID: "groupID5", MemberID: "userID1", Role: models.GroupMemberRoleMember},
+		"groupID6:userID1": {GroupID: "groupID6", MemberID: "userID1", Role: models.GroupMemberRoleAdmin},
+	}
+
+	getGroupMember := func(groupID, memberID string) *models.GroupMember {
+		return groupMembers[groupID+":"+memberID]
+	}
+
+	getGroupMemberErr := func(groupID, memberID string) error {
+		if _, isExist := groupMembers[groupID+":"+memberID]; !isExist {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	}
+
+	// groupID6 has a second admin
+	deleteAdminIfNotLast := func(groupID, memberID string) bool {
+		return groupID == "groupID6"
+	}
+
+	joinRequests := map[string]*models.GroupJoinRequest{
+		"requestID1": {ID: "requestID1", GroupID: "groupID1", RequesterID: "userID2", CreatedAt: time.Now()},
+		"requestID2": {ID: "requestID2", GroupID: "groupID5", RequesterID: "userID2", CreatedAt: time.Now()},
+		"requestID4": {ID: "requestID4", GroupID: "groupID1", RequesterID: "userID6", CreatedAt: time.Now()},
+	}
+
+	getJoinRequest := func(id string) *models.GroupJoinRequest {
+		return joinRequests[id]
+	}
+
+	getJoinRequestErr := func(id string) error {
+		if _, isExist := joinRequests[id]; !isExist {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	}
+
+	invitations := map[string]*models.GroupInvitation{
+		"invitationID1": {ID: "invitationID1", GroupID: "groupID1", InviterID: "userID1", InviteeID: "userID2", CreatedAt: time.Now()},
+		"invitationID2": {ID: "invitationID2", GroupID: "groupID1", InviterID: "userID1", InviteeID: "userID2", CreatedAt: time.Now().Add(-group.InvitationExpiration - time.Hour)},
+		"invitationID3": {ID: "invitationID3", GroupID: "groupID9", InviterID: "userID1", InviteeID: "userID2", CreatedAt: time.Now()},
+		"invitationID5": {ID: "invitationID5", GroupID: "groupID1", InviterID: "userID6", InviteeID: "userID2", CreatedAt: time.Now()},
+	}
+
+	getInvitation := func(id string) *models.GroupInvitation {
+		return invitations[id]
+	}
+
+	getInvitationErr := func(id string) error {
+		if _, isExist := invitations[id]; !isExist {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	}
+
+	getUserByID := func(id string) *models.User {
+		return &models.User{ID: id, Username: strings.Replace(id, "userID", "username", 1)}
+	}
+
+	getUserByUsername := func(username string) *models.User {
+		if username != "username3" {
+			return nil
+		}
+
+		return &models.User{ID: "userID3", Username: username}
+	}
+
+	getUserByUsernameErr := func(username string) error {
+		if username != "username3" {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	}
+
+	// userID6 has blocked userID1 and userID2
+	isBlockedBetween := func(userID, otherUserID string) bool {
+		return (userID == "userID6" && (otherUserID == "userID1" || otherUserID == "userID2")) ||
+			(otherUserID == "userID6" && (userID == "userID1" || userID == "userID2"))
+	}
+
 	s.groupRepo.On("CreateTransaction", mock.Anything).Return(nil)
+	s.groupRepo.On("GetByID", "groupID5").Return(&models.Group{ID: "groupID5", CreatorID: "userID2", IsOpen: true}, nil)
+	s.groupRepo.On("GetByID", "groupID6").Return(&models.Group{ID: "groupID6", CreatorID: "userID2", IsOpen: true}, nil)
+	s.groupRepo.On("GetByID", "groupID1").Return(&models.Group{ID: "groupID1", Name: "hololive", CreatorID: "userID1"}, nil)
+	s.groupRepo.On("GetByID", mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	s.groupRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getGroupsByIDs, nil)
+	s.groupRepo.On("Search", mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupSort"), mock.Anything, mock.AnythingOfType("int")).Return(searchGroups, nil)
+	s.groupMemberRepo.On("Create", mock.AnythingOfType("*models.GroupMember")).Return(nil)
+	s.groupMemberRepo.On("Get", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(getGroupMember, getGroupMemberErr)
+	s.groupMemberRepo.On("Delete", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	s.groupMemberRepo.On("DeleteAdminIfNotLast", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(deleteAdminIfNotLast, nil)
+	s.groupMemberRepo.On("GetByMemberID", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(getGroupMembersByMemberID, nil)
+	s.groupJoinRequestRepo.On("Create", mock.AnythingOfType("*models.GroupJoinRequest")).Return(nil)
+	s.groupJoinRequestRepo.On("Delete", mock.AnythingOfType("string")).Return(nil)
+	s.groupJoinRequestRepo.On("GetByID", mock.AnythingOfType("string")).Return(getJoinRequest, getJoinRequestErr)
+	s.groupJoinRequestRepo.On("GetByGroupID", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.GroupJoinRequest{joinRequests["requestID1"]}, nil)
+	s.groupJoinRequestRepo.On("IsRequested", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(func(groupID, requesterID string) bool { return requesterID == "userID3" }, nil)
+	s.groupJoinRequestRepo.On("Approve", mock.AnythingOfType("*models.GroupJoinRequest")).Return(true, nil)
+	s.groupInvitationRepo.On("Create", mock.AnythingOfType("*models.GroupInvitation")).Return(func(invitation *models.GroupInvitation) error {
+		invitation.CreatedAt = time.Now()
+		return nil
+	})
+	s.groupInvitationRepo.On("Delete", mock.AnythingOfType("string")).Return(nil)
+	s.groupInvitationRepo.On("GetByID", mock.AnythingOfType("string")).Return(getInvitation, getInvitationErr)
+	s.groupInvitationRepo.On("GetByInviteeID", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.Anything, mock.AnythingOfType("int")).Return([]*models.GroupInvitation{invitations["invitationID1"], invitations["invitationID3"]}, nil)
+	s.groupInvitationRepo.On("IsInvited", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(func(groupID, inviteeID string, createdAfter time.Time) bool { return inviteeID == "userID3" }, nil)
+	s.groupInvitationRepo.On("Accept", mock.AnythingOfType("*models.GroupInvitation")).Return(true, nil)
+	s.userRepo.On("GetByID", mock.AnythingOfType("string")).Return(getUserByID, nil)
+	s.userRepo.On("GetByUsername", mock.AnythingOfType("string")).Return(getUserByUsername, getUserByUsernameErr)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return([]*models.User{{ID: "userID1", Username: "username1"}, {ID: "userID2", Username: "username2"}}, nil)
+	s.userRepo.On("IsIDExist", mock.AnythingOfType("string")).Return(func(userID string) bool { return userID != "userID3" }, nil)
+	s.blockRepo.On("IsBlockedBetween", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isBlockedBetween, nil)
 	s.storageMock.On("AssignImageURLToGroup", mock.AnythingOfType("*models.Group"))
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
 
-	s.usecase = usecase.NewGroupUsecase(s.groupRepo, s.groupMemberRepo, s.userRepo, s.storageMock)
+	s.usecase = usecase.NewGroupUsecase(s.groupRepo, s.groupMemberRepo, s.groupJoinRequestRepo, s.groupInvitationRepo, s.userRepo, s.blockRepo, s.storageMock)
 }
 
 func (s *groupUsecaseSuite) TestCreateGroupNameTooShort() {
@@ -117,3 +291,413 @@ func (s *groupUsecaseSuite) TestCreateGroupSuccessful() {
 	s.groupRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 1)
 	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToGroup", 1)
 }
+
+func (s *groupUsecaseSuite) TestGetGroupNotFound() {
+	result, err := s.usecase.Get("groupID2")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), gorm.ErrRecordNotFound, err)
+	s.userRepo.AssertNumberOfCalls(s.T(), "GetByID", 0)
+}
+
+func (s *groupUsecaseSuite) TestGetGroupSuccessful() {
+	result, err := s.usecase.Get("groupID1")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "groupID1", result.ID)
+	assert.Equal(s.T(), "username1", result.Creator.Username)
+	s.userRepo.AssertCalled(s.T(), "GetByIDs", []string{"userID1"})
+	s.storageMock.AssertCalled(s.T(), "AssignImageURLToUser", result.Creator)
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToGroup", 1)
+}
+
+func (s *groupUsecaseSuite) TestEditGroupNotFound() {
+	result, err := s.usecase.Edit("groupID9", map[string]string{"name": "hololive jp"}, nil)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), gorm.ErrRecordNotFound.Error(), err.Error())
+	s.groupRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *groupUsecaseSuite) TestEditGroupIsOpenInvalid() {
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrIsOpenInvalid}}
+	result, err := s.usecase.Edit("groupID1", map[string]string{"is_open": "yes"}, nil)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.groupRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *groupUsecaseSuite) TestEditGroupNameTooShort() {
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrGroupNameTooShort}}
+	result, err := s.usecase.Edit("groupID1", map[string]string{"name": "a"}, nil)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.groupRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *groupUsecaseSuite) TestEditGroupImageInvalidFormat() {
+	imgFile, _ := os.Open("../../assets/images/test_pic.gif")
+	defer imgFile.Close()
+	imgFileReader := utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name())))
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrGroupImageInvalidFormat}}
+	result, err := s.usecase.Edit("groupID1", map[string]string{}, imgFileReader)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.groupRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *groupUsecaseSuite) TestEditGroupSuccessful() {
+	imgFile, _ := os.Open("../../assets/images/default-profile.png")
+	defer imgFile.Close()
+	imgFileReader := utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name())))
+
+	updates := map[string]string{"name": "hololive jp", "description": "hololive japan", "is_open": "true"}
+	result, err := s.usecase.Edit("groupID1", updates, imgFileReader)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "hololive jp", result.Name)
+	assert.Equal(s.T(), "hololive japan", result.Description)
+	assert.True(s.T(), result.IsOpen)
+	assert.Equal(s.T(), "username1", result.Creator.Username)
+	assert.Len(s.T(), result.Images, 2)
+	assert.Equal(s.T(), group.ThumbnailPictureRes, result.Images[0].Width)
+	assert.Equal(s.T(), group.BannerPictureWidth, result.Images[1].Width)
+	assert.Equal(s.T(), group.BannerPictureHeight, result.Images[1].Height)
+	s.groupRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 1)
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToGroup", 1)
+}
+
+func (s *groupUsecaseSuite) TestGetGroupsInvalidSort() {
+	groups, _, err := s.usecase.GetGroups("holo", models.GroupSort("name"), nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), groups)
+	assert.Equal(s.T(), custom_errors.ErrInvalidGroupSort.Error(), err.Error())
+	s.groupRepo.AssertNumberOfCalls(s.T(), "Search", 0)
+}
+
+func (s *groupUsecaseSuite) TestGetGroupsWithNextPage() {
+	groups, nextCursor, err := s.usecase.GetGroups(" holo ", models.GroupSortMemberCount, nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), groups, 2)
+	assert.Equal(s.T(), "username1", groups[0].Creator.Username)
+	assert.Equal(s.T(), "username2", groups[1].Creator.Username)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), "groupID2", nextCursor.ID)
+	assert.Equal(s.T(), gutGroups[1].CreatedAt, nextCursor.CreatedAt)
+	assert.Equal(s.T(), uint(2), *nextCursor.Count)
+	s.groupRepo.AssertCalled(s.T(), "Search", "holo", models.GroupSortMemberCount, mock.Anything, 3)
+}
+
+func (s *groupUsecaseSuite) TestGetGroupsByMemberCountWithoutCount() {
+	groups, _, err := s.usecase.GetGroups("holo", models.GroupSortMemberCount, utils.NewCursor(time.Now(), "groupID2"), 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), groups)
+	assert.Equal(s.T(), custom_errors.ErrInvalidCursor.Error(), err.Error())
+	s.groupRepo.AssertNumberOfCalls(s.T(), "Search", 0)
+}
+
+func (s *groupUsecaseSuite) TestGetGroupsLastPage() {
+	groups, nextCursor, err := s.usecase.GetGroups("", models.GroupSortCreatedAt, nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), groups, 3)
+	assert.Nil(s.T(), nextCursor)
+}
+
+func (s *groupUsecaseSuite) TestGetUserGroupsUserNotFound() {
+	groups, _, err := s.usecase.GetUserGroups("userID3", nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), groups)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "GetByMemberID", 0)
+}
+
+func (s *groupUsecaseSuite) TestGetUserGroupsSuccessful() {
+	groups, nextCursor, err := s.usecase.GetUserGroups("userID1", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	s.groupMemberRepo.AssertCalled(s.T(), "GetByMemberID", "userID1", mock.Anything, 21)
+
+	// groupID4 has been deleted since the user joined it
+	assert.Len(s.T(), groups, 2)
+	assert.Equal(s.T(), "groupID2", groups[0].ID)
+	assert.Equal(s.T(), models.GroupMemberRoleMember, groups[0].Role)
+	assert.Equal(s.T(), "groupID1", groups[1].ID)
+	assert.Equal(s.T(), models.GroupMemberRoleAdmin, groups[1].Role)
+	assert.Equal(s.T(), "username1", groups[1].Creator.Username)
+}
+
+func (s *groupUsecaseSuite) TestJoinGroupNotFound() {
+	_, err := s.usecase.JoinGroup("groupID2", "userID2")
+
+	assert.Equal(s.T(), gorm.ErrRecordNotFound, err)
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *groupUsecaseSuite) TestJoinGroupAlreadyMember() {
+	_, err := s.usecase.JoinGroup("groupID5", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrAlreadyGroupMember.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *groupUsecaseSuite) TestJoinClosedGroupAlreadyMember() {
+	_, err := s.usecase.JoinGroup("groupID1", "userID5")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrAlreadyGroupMember.Error(), err.Error())
+	s.groupJoinRequestRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *groupUsecaseSuite) TestJoinClosedGroupAlreadyRequested() {
+	_, err := s.usecase.JoinGroup("groupID1", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupJoinRequestAlreadyExist.Error(), err.Error())
+	s.groupJoinRequestRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *groupUsecaseSuite) TestJoinClosedGroupCreatesRequest() {
+	request, err := s.usecase.JoinGroup("groupID1", "userID2")
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), request)
+	assert.NotEmpty(s.T(), request.ID)
+	assert.Equal(s.T(), "groupID1", request.GroupID)
+	assert.Equal(s.T(), "userID2", request.RequesterID)
+	s.groupJoinRequestRepo.AssertCalled(s.T(), "Create", request)
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *groupUsecaseSuite) TestJoinGroupSuccessful() {
+	request, err := s.usecase.JoinGroup("groupID5", "userID2")
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), request)
+	s.groupJoinRequestRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+	s.groupMemberRepo.AssertCalled(s.T(), "Create", &models.GroupMember{GroupID: "groupID5", MemberID: "userID2", Role: models.GroupMemberRoleMember})
+}
+
+func (s *groupUsecaseSuite) TestLeaveGroupNotMember() {
+	err := s.usecase.LeaveGroup("groupID5", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrNotGroupMember.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *groupUsecaseSuite) TestLeaveGroupLastAdmin() {
+	err := s.usecase.LeaveGroup("groupID1", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrLastGroupAdmin.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *groupUsecaseSuite) TestLeaveGroupAdminSuccessful() {
+	err := s.usecase.LeaveGroup("groupID6", "userID1")
+
+	assert.NoError(s.T(), err)
+	s.groupMemberRepo.AssertCalled(s.T(), "DeleteAdminIfNotLast", "groupID6", "userID1")
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *groupUsecaseSuite) TestLeaveGroupMemberSuccessful() {
+	err := s.usecase.LeaveGroup("groupID5", "userID1")
+
+	assert.NoError(s.T(), err)
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "DeleteAdminIfNotLast", 0)
+	s.groupMemberRepo.AssertCalled(s.T(), "Delete", "groupID5", "userID1")
+}
+
+func (s *groupUsecaseSuite) TestGetJoinRequestsSuccessful() {
+	requests, nextCursor, err := s.usecase.GetJoinRequests("groupID1", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	assert.Len(s.T(), requests, 1)
+	assert.Equal(s.T(), "username2", requests[0].Requester.Username)
+	s.groupJoinRequestRepo.AssertCalled(s.T(), "GetByGroupID", "groupID1", mock.Anything, 21)
+}
+
+func (s *groupUsecaseSuite) TestApproveJoinRequestOfOtherGroup() {
+	err := s.usecase.ApproveJoinRequest("groupID1", "requestID2", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupJoinRequestNotFound.Error(), err.Error())
+	s.groupJoinRequestRepo.AssertNumberOfCalls(s.T(), "Approve", 0)
+}
+
+func (s *groupUsecaseSuite) TestApproveJoinRequestNotFound() {
+	err := s.usecase.ApproveJoinRequest("groupID1", "requestID3", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupJoinRequestNotFound.Error(), err.Error())
+}
+
+func (s *groupUsecaseSuite) TestApproveJoinRequestBlocked() {
+	err := s.usecase.ApproveJoinRequest("groupID1", "requestID4", "userID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.groupJoinRequestRepo.AssertNumberOfCalls(s.T(), "Approve", 0)
+}
+
+func (s *groupUsecaseSuite) TestApproveJoinRequestSuccessful() {
+	err := s.usecase.ApproveJoinRequest("groupID1", "requestID1", "userID1")
+
+	assert.NoError(s.T(), err)
+	s.groupJoinRequestRepo.AssertNumberOfCalls(s.T(), "Approve", 1)
+}
+
+func (s *groupUsecaseSuite) TestRejectJoinRequestSuccessful() {
+	err := s.usecase.RejectJoinRequest("groupID1", "requestID1")
+
+	assert.NoError(s.T(), err)
+	s.groupJoinRequestRepo.AssertCalled(s.T(), "Delete", "requestID1")
+}
+
+func (s *groupUsecaseSuite) TestInviteUserInviteeMissing() {
+	_, err := s.usecase.InviteUser("groupID1", "userID1", "", "")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupInviteeMissing.Error(), err.Error())
+}
+
+func (s *groupUsecaseSuite) TestInviteUserInviteeNotFound() {
+	_, err := s.usecase.InviteUser("groupID1", "userID1", "", "username9")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+}
+
+func (s *groupUsecaseSuite) TestInviteUserAlreadyMember() {
+	_, err := s.usecase.InviteUser("groupID1", "userID1", "userID5", "")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrAlreadyGroupMember.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *groupUsecaseSuite) TestInviteUserAlreadyInvited() {
+	_, err := s.usecase.InviteUser("groupID1", "userID1", "", "username3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupInvitationAlreadyExist.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *groupUsecaseSuite) TestInviteUserBlocked() {
+	invitation, err := s.usecase.InviteUser("groupID1", "userID1", "userID6", "")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), invitation)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *groupUsecaseSuite) TestInviteUserSuccessful() {
+	invitation, err := s.usecase.InviteUser("groupID1", "userID4", "userID2", "")
+
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), invitation.ID)
+	assert.Equal(s.T(), "groupID1", invitation.GroupID)
+	assert.Equal(s.T(), "userID4", invitation.InviterID)
+	assert.Equal(s.T(), "username2", invitation.Invitee.Username)
+	assert.Equal(s.T(), invitation.CreatedAt.Add(group.InvitationExpiration), invitation.ExpiresAt)
+	s.groupInvitationRepo.AssertCalled(s.T(), "Create", invitation)
+}
+
+func (s *groupUsecaseSuite) TestGetInvitationsSuccessful() {
+	invitations, nextCursor, err := s.usecase.GetInvitations("userID2", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	s.groupInvitationRepo.AssertCalled(s.T(), "GetByInviteeID", "userID2", mock.AnythingOfType("time.Time"), mock.Anything, 21)
+
+	// groupID9 has been deleted since the invitation was sent
+	assert.Len(s.T(), invitations, 1)
+	assert.Equal(s.T(), "invitationID1", invitations[0].ID)
+	assert.Equal(s.T(), "groupID1", invitations[0].Group.ID)
+	assert.Equal(s.T(), "username1", invitations[0].Group.Creator.Username)
+	assert.Equal(s.T(), "username1", invitations[0].Inviter.Username)
+	assert.Equal(s.T(), invitations[0].CreatedAt.Add(group.InvitationExpiration), invitations[0].ExpiresAt)
+}
+
+func (s *groupUsecaseSuite) TestAcceptInvitationOfOtherUser() {
+	err := s.usecase.AcceptInvitation("groupID1", "invitationID1", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupInvitationNotFound.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Accept", 0)
+}
+
+func (s *groupUsecaseSuite) TestAcceptInvitationOfOtherGroup() {
+	err := s.usecase.AcceptInvitation("groupID2", "invitationID1", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupInvitationNotFound.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Accept", 0)
+}
+
+func (s *groupUsecaseSuite) TestAcceptInvitationExpired() {
+	err := s.usecase.AcceptInvitation("groupID1", "invitationID2", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupInvitationNotFound.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Accept", 0)
+}
+
+func (s *groupUsecaseSuite) TestAcceptInvitationBlocked() {
+	err := s.usecase.AcceptInvitation("groupID1", "invitationID5", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Accept", 0)
+}
+
+func (s *groupUsecaseSuite) TestAcceptInvitationSuccessful() {
+	err := s.usecase.AcceptInvitation("groupID1", "invitationID1", "userID2")
+
+	assert.NoError(s.T(), err)
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Accept", 1)
+	s.groupRepo.AssertNumberOfCalls(s.T(), "GetByID", 0)
+}
+
+func (s *groupUsecaseSuite) TestDeclineInvitationNotFound() {
+	err := s.usecase.DeclineInvitation("groupID1", "invitationID4", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupInvitationNotFound.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *groupUsecaseSuite) TestDeclineInvitationOfOtherGroup() {
+	err := s.usecase.DeclineInvitation("groupID2", "invitationID1", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupInvitationNotFound.Error(), err.Error())
+	s.groupInvitationRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *groupUsecaseSuite) TestDeclineInvitationSuccessful() {
+	err := s.usecase.DeclineInvitation("groupID1", "invitationID1", "userID2")
+
+	assert.NoError(s.T(), err)
+	s.groupInvitationRepo.AssertCalled(s.T(), "Delete", "invitationID1")
+}
@@ -0,0 +1,17 @@
+package group_invitation
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type Repository interface {
+	Create(invitation *models.GroupInvitation) error
+	Delete(id string) error
+	GetByID(id string) (*models.GroupInvitation, error)
+	GetByInviteeID(inviteeID string, createdAfter time.Time, cursor *utils.Cursor, limit int) ([]*models.GroupInvitation, error)
+	IsInvited(groupID, inviteeID string, createdAfter time.Time) (bool, error)
+	Accept(invitation *models.GroupInvitation) (bool, error)
+}
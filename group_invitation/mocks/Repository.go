@@ -0,0 +1,148 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: invitation
+func (_m *Repository) Accept(invitation *models.GroupInvitation) (bool, error) {
+	ret := _m.Called(invitation)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*models.GroupInvitation) bool); ok {
+		r0 = rf(invitation)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.GroupInvitation) error); ok {
+		r1 = rf(invitation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: invitation
+func (_m *Repository) Create(invitation *models.GroupInvitation) error {
+	ret := _m.Called(invitation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.GroupInvitation) error); ok {
+		r0 = rf(invitation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *Repository) Delete(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *Repository) GetByID(id string) (*models.GroupInvitation, error) {
+	ret := _m.Called(id)
+
+	var r0 *models.GroupInvitation
+	if rf, ok := ret.Get(0).(func(string) *models.GroupInvitation); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.GroupInvitation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByInviteeID provides a mock function with given fields: inviteeID, createdAfter, cursor, limit
+func (_m *Repository) GetByInviteeID(inviteeID string, createdAfter time.Time, cursor *utils.Cursor, limit int) ([]*models.GroupInvitation, error) {
+	ret := _m.Called(inviteeID, createdAfter, cursor, limit)
+
+	var r0 []*models.GroupInvitation
+	if rf, ok := ret.Get(0).(func(string, time.Time, *utils.Cursor, int) []*models.GroupInvitation); ok {
+		r0 = rf(inviteeID, createdAfter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.GroupInvitation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time, *utils.Cursor, int) error); ok {
+		r1 = rf(inviteeID, createdAfter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsInvited provides a mock function with given fields: groupID, inviteeID, createdAfter
+func (_m *Repository) IsInvited(groupID string, inviteeID string, createdAfter time.Time) (bool, error) {
+	ret := _m.Called(groupID, inviteeID, createdAfter)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) bool); ok {
+		r0 = rf(groupID, inviteeID, createdAfter)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(groupID, inviteeID, createdAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/group_invitation"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type groupInvitationRepository struct {
+	DB *gorm.DB
+}
+
+func NewGroupInvitationRepository(db *gorm.DB) group_invitation.Repository {
+	return &groupInvitationRepository{DB: db}
+}
+
+func (repo *groupInvitationRepository) Create(invitation *models.GroupInvitation) error {
+	return repo.DB.Create(invitation).Error
+}
+
+func (repo *groupInvitationRepository) Delete(id string) error {
+	return repo.DB.Where("id = ?", id).Delete(&models.GroupInvitation{}).Error
+}
+
+func (repo *groupInvitationRepository) GetByID(id string) (*models.GroupInvitation, error) {
+	invitation := &models.GroupInvitation{}
+
+	err := repo.DB.Table("group_invitations").Where("id = ?", id).First(invitation).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+// GetByInviteeID returns the invitations of the user created after createdAfter, newest first.
+func (repo *groupInvitationRepository) GetByInviteeID(inviteeID string, createdAfter time.Time, cursor *utils.Cursor, limit int) ([]*models.GroupInvitation, error) {
+	invitations := make([]*models.GroupInvitation, 0)
+
+	query := repo.DB.Table("group_invitations").Where("invitee_id = ? AND created_at > ?", inviteeID, createdAfter)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&invitations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+// IsInvited reports whether the user has an invitation to the group created after createdAfter.
+func (repo *groupInvitationRepository) IsInvited(groupID, inviteeID string, createdAfter time.Time) (bool, error) {
+	var count int64
+	err := repo.DB.Table("group_invitations").Where("group_id = ? AND invitee_id = ? AND created_at > ?", groupID, inviteeID, createdAfter).Count(&count).Error
+	return count > 0, err
+}
+
+// Accept turns the invitation into a group membership, removing the invitee's other invitations and join
+// request for the group. The returned bool is false when the invitation no longer exists.
+func (repo *groupInvitationRepository) Accept(invitation *models.GroupInvitation) (bool, error) {
+	isAccepted := false
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", invitation.ID).Delete(&models.GroupInvitation{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Where("group_id = ? AND invitee_id = ?", invitation.GroupID, invitation.InviteeID).Delete(&models.GroupInvitation{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("group_id = ? AND requester_id = ?", invitation.GroupID, invitation.InviteeID).Delete(&models.GroupJoinRequest{}).Error
+		if err != nil {
+			return err
+		}
+
+		groupMember := &models.GroupMember{
+			GroupID:   invitation.GroupID,
+			MemberID:  invitation.InviteeID,
+			Role:      models.GroupMemberRoleMember,
+			CreatedAt: time.Now(),
+		}
+
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(groupMember).Error
+		if err != nil {
+			return err
+		}
+
+		isAccepted = true
+		return nil
+	})
+
+	return isAccepted, err
+}
@@ -0,0 +1,15 @@
+package group_join_request
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type Repository interface {
+	Create(request *models.GroupJoinRequest) error
+	Delete(id string) error
+	GetByID(id string) (*models.GroupJoinRequest, error)
+	GetByGroupID(groupID string, cursor *utils.Cursor, limit int) ([]*models.GroupJoinRequest, error)
+	IsRequested(groupID, requesterID string) (bool, error)
+	Approve(request *models.GroupJoinRequest) (bool, error)
+}
@@ -0,0 +1,146 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Approve provides a mock function with given fields: request
+func (_m *Repository) Approve(request *models.GroupJoinRequest) (bool, error) {
+	ret := _m.Called(request)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*models.GroupJoinRequest) bool); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.GroupJoinRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: request
+func (_m *Repository) Create(request *models.GroupJoinRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.GroupJoinRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *Repository) Delete(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByGroupID provides a mock function with given fields: groupID, cursor, limit
+func (_m *Repository) GetByGroupID(groupID string, cursor *utils.Cursor, limit int) ([]*models.GroupJoinRequest, error) {
+	ret := _m.Called(groupID, cursor, limit)
+
+	var r0 []*models.GroupJoinRequest
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.GroupJoinRequest); ok {
+		r0 = rf(groupID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.GroupJoinRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) error); ok {
+		r1 = rf(groupID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *Repository) GetByID(id string) (*models.GroupJoinRequest, error) {
+	ret := _m.Called(id)
+
+	var r0 *models.GroupJoinRequest
+	if rf, ok := ret.Get(0).(func(string) *models.GroupJoinRequest); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.GroupJoinRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsRequested provides a mock function with given fields: groupID, requesterID
+func (_m *Repository) IsRequested(groupID string, requesterID string) (bool, error) {
+	ret := _m.Called(groupID, requesterID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(groupID, requesterID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(groupID, requesterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/group_join_request"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type groupJoinRequestRepository struct {
+	DB *gorm.DB
+}
+
+func NewGroupJoinRequestRepository(db *gorm.DB) group_join_request.Repository {
+	return &groupJoinRequestRepository{DB: db}
+}
+
+func (repo *groupJoinRequestRepository) Create(request *models.GroupJoinRequest) error {
+	return repo.DB.Create(request).Error
+}
+
+func (repo *groupJoinRequestRepository) Delete(id string) error {
+	return repo.DB.Where("id = ?", id).Delete(&models.GroupJoinRequest{}).Error
+}
+
+func (repo *groupJoinRequestRepository) GetByID(id string) (*models.GroupJoinRequest, error) {
+	request := &models.GroupJoinRequest{}
+
+	err := repo.DB.Table("group_join_requests").Where("id = ?", id).First(request).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// GetByGroupID returns the pending join requests of the group, newest first.
+func (repo *groupJoinRequestRepository) GetByGroupID(groupID string, cursor *utils.Cursor, limit int) ([]*models.GroupJoinRequest, error) {
+	requests := make([]*models.GroupJoinRequest, 0)
+
+	query := repo.DB.Table("group_join_requests").Where("group_id = ?", groupID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&requests).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (repo *groupJoinRequestRepository) IsRequested(groupID, requesterID string) (bool, error) {
+	var count int64
+	err := repo.DB.Table("group_join_requests").Where("group_id = ? AND requester_id = ?", groupID, requesterID).Count(&count).Error
+	return count > 0, err
+}
+
+// Approve turns the join request into a group membership, removing the requester's invitations to the group.
+// The returned bool is false when the request no longer exists.
+func (repo *groupJoinRequestRepository) Approve(request *models.GroupJoinRequest) (bool, error) {
+	isApproved := false
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", request.ID).Delete(&models.GroupJoinRequest{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Where("group_id = ? AND invitee_id = ?", request.GroupID, request.RequesterID).Delete(&models.GroupInvitation{}).Error
+		if err != nil {
+			return err
+		}
+
+		groupMember := &models.GroupMember{
+			GroupID:   request.GroupID,
+			MemberID:  request.RequesterID,
+			Role:      models.GroupMemberRoleMember,
+			CreatedAt: time.Now(),
+		}
+
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(groupMember).Error
+		if err != nil {
+			return err
+		}
+
+		isApproved = true
+		return nil
+	})
+
+	return isApproved, err
+}
@@ -1,7 +1,21 @@
 package group_member
 
-import "github.com/jordyf15/tweeter-api/models"
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type Usecase interface {
+	VerifyPermission(groupID, userID string, action models.GroupAction) error
+	UpdateRole(groupID, memberID, userID string, role models.GroupMemberRole) (*models.GroupMember, error)
+	Remove(groupID, memberID, userID string) error
+}
 
 type Repository interface {
 	Create(groupMember *models.GroupMember) error
+	Get(groupID, memberID string) (*models.GroupMember, error)
+	Delete(groupID, memberID string) error
+	UpdateRole(groupID, memberID string, role models.GroupMemberRole) error
+	DeleteAdminIfNotLast(groupID, memberID string) (bool, error)
+	GetByMemberID(memberID string, cursor *utils.Cursor, limit int) ([]*models.GroupMember, error)
 }
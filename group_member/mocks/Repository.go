@@ -5,6 +5,8 @@ package mocks
 import (
 	models "github.com/jordyf15/tweeter-api/models"
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -26,6 +28,101 @@ func (_m *Repository) Create(groupMember *models.GroupMember) error {
 	return r0
 }
 
+// Delete provides a mock function with given fields: groupID, memberID
+func (_m *Repository) Delete(groupID string, memberID string) error {
+	ret := _m.Called(groupID, memberID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(groupID, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAdminIfNotLast provides a mock function with given fields: groupID, memberID
+func (_m *Repository) DeleteAdminIfNotLast(groupID string, memberID string) (bool, error) {
+	ret := _m.Called(groupID, memberID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(groupID, memberID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(groupID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: groupID, memberID
+func (_m *Repository) Get(groupID string, memberID string) (*models.GroupMember, error) {
+	ret := _m.Called(groupID, memberID)
+
+	var r0 *models.GroupMember
+	if rf, ok := ret.Get(0).(func(string, string) *models.GroupMember); ok {
+		r0 = rf(groupID, memberID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.GroupMember)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(groupID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByMemberID provides a mock function with given fields: memberID, cursor, limit
+func (_m *Repository) GetByMemberID(memberID string, cursor *utils.Cursor, limit int) ([]*models.GroupMember, error) {
+	ret := _m.Called(memberID, cursor, limit)
+
+	var r0 []*models.GroupMember
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.GroupMember); ok {
+		r0 = rf(memberID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.GroupMember)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) error); ok {
+		r1 = rf(memberID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRole provides a mock function with given fields: groupID, memberID, role
+func (_m *Repository) UpdateRole(groupID string, memberID string, role models.GroupMemberRole) error {
+	ret := _m.Called(groupID, memberID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, models.GroupMemberRole) error); ok {
+		r0 = rf(groupID, memberID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,79 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Usecase is an autogenerated mock type for the Usecase type
+type Usecase struct {
+	mock.Mock
+}
+
+// Remove provides a mock function with given fields: groupID, memberID, userID
+func (_m *Usecase) Remove(groupID string, memberID string, userID string) error {
+	ret := _m.Called(groupID, memberID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(groupID, memberID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRole provides a mock function with given fields: groupID, memberID, userID, role
+func (_m *Usecase) UpdateRole(groupID string, memberID string, userID string, role models.GroupMemberRole) (*models.GroupMember, error) {
+	ret := _m.Called(groupID, memberID, userID, role)
+
+	var r0 *models.GroupMember
+	if rf, ok := ret.Get(0).(func(string, string, string, models.GroupMemberRole) *models.GroupMember); ok {
+		r0 = rf(groupID, memberID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.GroupMember)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, models.GroupMemberRole) error); ok {
+		r1 = rf(groupID, memberID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyPermission provides a mock function with given fields: groupID, userID, action
+func (_m *Usecase) VerifyPermission(groupID string, userID string, action models.GroupAction) error {
+	ret := _m.Called(groupID, userID, action)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, models.GroupAction) error); ok {
+		r0 = rf(groupID, userID, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewUsecase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUsecase creates a new instance of Usecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUsecase(t mockConstructorTestingTNewUsecase) *Usecase {
+	mock := &Usecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,7 +3,9 @@ package repository
 import (
 	"github.com/jordyf15/tweeter-api/group_member"
 	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type groupMemberRepository struct {
@@ -17,3 +19,72 @@ func NewGroupMemberRepository(db *gorm.DB) group_member.Repository {
 func (repo *groupMemberRepository) Create(groupMember *models.GroupMember) error {
 	return repo.DB.Create(groupMember).Error
 }
+
+func (repo *groupMemberRepository) Get(groupID, memberID string) (*models.GroupMember, error) {
+	groupMember := &models.GroupMember{}
+
+	err := repo.DB.Table("group_members").Where("group_id = ? AND member_id = ?", groupID, memberID).First(groupMember).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return groupMember, nil
+}
+
+func (repo *groupMemberRepository) Delete(groupID, memberID string) error {
+	return repo.DB.Where("group_id = ? AND member_id = ?", groupID, memberID).Delete(&models.GroupMember{}).Error
+}
+
+func (repo *groupMemberRepository) UpdateRole(groupID, memberID string, role models.GroupMemberRole) error {
+	return repo.DB.Model(&models.GroupMember{}).Where("group_id = ? AND member_id = ?", groupID, memberID).Update("role", role).Error
+}
+
+// DeleteAdminIfNotLast removes the admin from the group unless they are its only admin, in which case it returns false.
+// The admin rows of the group are locked while they are counted so that concurrent leaves cannot remove every admin.
+func (repo *groupMemberRepository) DeleteAdminIfNotLast(groupID, memberID string) (bool, error) {
+	isDeleted := false
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		adminIDs := make([]string, 0)
+		err := tx.Table("group_members").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("group_id = ? AND role = ?", groupID, models.GroupMemberRoleAdmin).
+			Pluck("member_id", &adminIDs).Error
+		if err != nil {
+			return err
+		}
+
+		if len(adminIDs) <= 1 {
+			return nil
+		}
+
+		result := tx.Where("group_id = ? AND member_id = ? AND role = ?", groupID, memberID, models.GroupMemberRoleAdmin).Delete(&models.GroupMember{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		isDeleted = result.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isDeleted, nil
+}
+
+// GetByMemberID returns the memberships of the user, most recently joined first.
+func (repo *groupMemberRepository) GetByMemberID(memberID string, cursor *utils.Cursor, limit int) ([]*models.GroupMember, error) {
+	groupMembers := make([]*models.GroupMember, 0)
+
+	query := repo.DB.Table("group_members").Where("member_id = ?", memberID)
+	if cursor != nil {
+		query = query.Where("(created_at, group_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, group_id DESC").Limit(limit).Find(&groupMembers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return groupMembers, nil
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/group"
+	"github.com/jordyf15/tweeter-api/group_member"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/user"
+	"gorm.io/gorm"
+)
+
+type groupMemberUsecase struct {
+	groupMemberRepo group_member.Repository
+	groupRepo       group.Repository
+	userRepo        user.Repository
+	storage         storage.Storage
+}
+
+func NewGroupMemberUsecase(groupMemberRepo group_member.Repository, groupRepo group.Repository, userRepo user.Repository, storage storage.Storage) group_member.Usecase {
+	return &groupMemberUsecase{groupMemberRepo: groupMemberRepo, groupRepo: groupRepo, userRepo: userRepo, storage: storage}
+}
+
+// VerifyPermission returns ErrGroupPermissionDenied unless the user is a member of the group whose role allows the action.
+func (usecase *groupMemberUsecase) VerifyPermission(groupID, userID string, action models.GroupAction) error {
+	_, err := usecase.getPermittedMember(groupID, userID, action)
+	return err
+}
+
+// UpdateRole promotes or demotes the member. The user can only change the role of members whose role they can manage.
+func (usecase *groupMemberUsecase) UpdateRole(groupID, memberID, userID string, role models.GroupMemberRole) (*models.GroupMember, error) {
+	if !role.IsValid() {
+		return nil, custom_errors.ErrInvalidGroupMemberRole
+	}
+
+	groupMember, err := usecase.getManageableMember(groupID, memberID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if groupMember.Role != role {
+		err = usecase.groupMemberRepo.UpdateRole(groupID, memberID, role)
+		if err != nil {
+			return nil, err
+		}
+
+		groupMember.Role = role
+	}
+
+	groupMember.Member, err = usecase.userRepo.GetByID(memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.storage.AssignImageURLToUser(groupMember.Member)
+
+	return groupMember, nil
+}
+
+// Remove removes the member from the group. The user can only remove members whose role they can manage.
+func (usecase *groupMemberUsecase) Remove(groupID, memberID, userID string) error {
+	_, err := usecase.getManageableMember(groupID, memberID, userID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.groupMemberRepo.Delete(groupID, memberID)
+}
+
+// getManageableMember returns the membership of memberID after checking that the user's role can manage its role.
+// Whether the user can do the action at all is checked by the group middleware.
+func (usecase *groupMemberUsecase) getManageableMember(groupID, memberID, userID string) (*models.GroupMember, error) {
+	currentMember, err := usecase.groupMemberRepo.Get(groupID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return nil, custom_errors.ErrGroupPermissionDenied
+	} else if err != nil {
+		return nil, err
+	}
+
+	groupMember, err := usecase.groupMemberRepo.Get(groupID, memberID)
+	if err == gorm.ErrRecordNotFound {
+		return nil, custom_errors.ErrNotGroupMember
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !currentMember.Role.CanManage(groupMember.Role) {
+		return nil, custom_errors.ErrGroupPermissionDenied
+	}
+
+	return groupMember, nil
+}
+
+func (usecase *groupMemberUsecase) getPermittedMember(groupID, userID string, action models.GroupAction) (*models.GroupMember, error) {
+	_, err := usecase.groupRepo.GetByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupMember, err := usecase.groupMemberRepo.Get(groupID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return nil, custom_errors.ErrGroupPermissionDenied
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !groupMember.Role.Can(action) {
+		return nil, custom_errors.ErrGroupPermissionDenied
+	}
+
+	return groupMember, nil
+}
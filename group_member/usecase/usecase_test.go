@@ -0,0 +1,180 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	groupMocks "github.com/jordyf15/tweeter-api/group/mocks"
+	"github.com/jordyf15/tweeter-api/group_member"
+	groupMemberMocks "github.com/jordyf15/tweeter-api/group_member/mocks"
+	"github.com/jordyf15/tweeter-api/group_member/usecase"
+	"github.com/jordyf15/tweeter-api/models"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestGroupMemberUsecase(t *testing.T) {
+	suite.Run(t, new(groupMemberUsecaseSuite))
+}
+
+type groupMemberUsecaseSuite struct {
+	suite.Suite
+	usecase         group_member.Usecase
+	groupMemberRepo *groupMemberMocks.Repository
+	groupRepo       *groupMocks.Repository
+	userRepo        *userMocks.Repository
+	storageMock     *storageMocks.Storage
+}
+
+// in groupID1, userID1 and userID2 are admins, userID3 and userID4 are moderators and userID5 and userID6 are members
+var gmutRoles = map[string]models.GroupMemberRole{
+	"userID1": models.GroupMemberRoleAdmin,
+	"userID2": models.GroupMemberRoleAdmin,
+	"userID3": models.GroupMemberRoleModerator,
+	"userID4": models.GroupMemberRoleModerator,
+	"userID5": models.GroupMemberRoleMember,
+	"userID6": models.GroupMemberRoleMember,
+}
+
+func (s *groupMemberUsecaseSuite) SetupTest() {
+	s.groupMemberRepo = new(groupMemberMocks.Repository)
+	s.groupRepo = new(groupMocks.Repository)
+	s.userRepo = new(userMocks.Repository)
+	s.storageMock = new(storageMocks.Storage)
+
+	getGroupMember := func(groupID, memberID string) *models.GroupMember {
+		role, isExist := gmutRoles[memberID]
+		if groupID != "groupID1" || !isExist {
+			return nil
+		}
+
+		return &models.GroupMember{GroupID: groupID, MemberID: memberID, Role: role}
+	}
+
+	getGroupMemberErr := func(groupID, memberID string) error {
+		if _, isExist := gmutRoles[memberID]; groupID != "groupID1" || !isExist {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	}
+
+	s.groupRepo.On("GetByID", "groupID1").Return(&models.Group{ID: "groupID1"}, nil)
+	s.groupRepo.On("GetByID", mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	s.groupMemberRepo.On("Get", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(getGroupMember, getGroupMemberErr)
+	s.groupMemberRepo.On("UpdateRole", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.GroupMemberRole")).Return(nil)
+	s.groupMemberRepo.On("Delete", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	s.userRepo.On("GetByID", mock.AnythingOfType("string")).Return(func(id string) *models.User { return &models.User{ID: id} }, nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
+
+	s.usecase = usecase.NewGroupMemberUsecase(s.groupMemberRepo, s.groupRepo, s.userRepo, s.storageMock)
+}
+
+func (s *groupMemberUsecaseSuite) TestVerifyPermissionGroupNotFound() {
+	err := s.usecase.VerifyPermission("groupID2", "userID1", models.GroupActionRemoveMember)
+
+	assert.Equal(s.T(), gorm.ErrRecordNotFound, err)
+}
+
+func (s *groupMemberUsecaseSuite) TestVerifyPermissionNotMember() {
+	err := s.usecase.VerifyPermission("groupID1", "userID7", models.GroupActionInviteMember)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupPermissionDenied.Error(), err.Error())
+}
+
+func (s *groupMemberUsecaseSuite) TestVerifyPermissionRoleNotAllowed() {
+	err := s.usecase.VerifyPermission("groupID1", "userID3", models.GroupActionChangeMemberRole)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupPermissionDenied.Error(), err.Error())
+}
+
+func (s *groupMemberUsecaseSuite) TestVerifyPermissionSuccessful() {
+	err := s.usecase.VerifyPermission("groupID1", "userID3", models.GroupActionRemoveMember)
+
+	assert.NoError(s.T(), err)
+}
+
+func (s *groupMemberUsecaseSuite) TestUpdateRoleInvalidRole() {
+	groupMember, err := s.usecase.UpdateRole("groupID1", "userID5", "userID1", models.GroupMemberRole("owner"))
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), groupMember)
+	assert.Equal(s.T(), custom_errors.ErrInvalidGroupMemberRole.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "UpdateRole", 0)
+}
+
+func (s *groupMemberUsecaseSuite) TestUpdateRoleOfAdmin() {
+	_, err := s.usecase.UpdateRole("groupID1", "userID2", "userID1", models.GroupMemberRoleMember)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupPermissionDenied.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "UpdateRole", 0)
+}
+
+func (s *groupMemberUsecaseSuite) TestUpdateRoleOfNonMember() {
+	_, err := s.usecase.UpdateRole("groupID1", "userID7", "userID1", models.GroupMemberRoleModerator)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrNotGroupMember.Error(), err.Error())
+}
+
+func (s *groupMemberUsecaseSuite) TestUpdateRolePromoteSuccessful() {
+	groupMember, err := s.usecase.UpdateRole("groupID1", "userID5", "userID1", models.GroupMemberRoleAdmin)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), models.GroupMemberRoleAdmin, groupMember.Role)
+	assert.Equal(s.T(), "userID5", groupMember.Member.ID)
+	s.groupMemberRepo.AssertCalled(s.T(), "UpdateRole", "groupID1", "userID5", models.GroupMemberRoleAdmin)
+}
+
+func (s *groupMemberUsecaseSuite) TestUpdateRoleDemoteSuccessful() {
+	groupMember, err := s.usecase.UpdateRole("groupID1", "userID3", "userID1", models.GroupMemberRoleMember)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), models.GroupMemberRoleMember, groupMember.Role)
+	s.groupMemberRepo.AssertCalled(s.T(), "UpdateRole", "groupID1", "userID3", models.GroupMemberRoleMember)
+}
+
+func (s *groupMemberUsecaseSuite) TestRemoveByMember() {
+	err := s.usecase.Remove("groupID1", "userID6", "userID5")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupPermissionDenied.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *groupMemberUsecaseSuite) TestRemoveAdminByModerator() {
+	err := s.usecase.Remove("groupID1", "userID1", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupPermissionDenied.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *groupMemberUsecaseSuite) TestRemoveModeratorByModerator() {
+	err := s.usecase.Remove("groupID1", "userID4", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrGroupPermissionDenied.Error(), err.Error())
+	s.groupMemberRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *groupMemberUsecaseSuite) TestRemoveMemberByModeratorSuccessful() {
+	err := s.usecase.Remove("groupID1", "userID5", "userID3")
+
+	assert.NoError(s.T(), err)
+	s.groupMemberRepo.AssertCalled(s.T(), "Delete", "groupID1", "userID5")
+}
+
+func (s *groupMemberUsecaseSuite) TestRemoveModeratorByAdminSuccessful() {
+	err := s.usecase.Remove("groupID1", "userID3", "userID1")
+
+	assert.NoError(s.T(), err)
+	s.groupMemberRepo.AssertCalled(s.T(), "Delete", "groupID1", "userID3")
+}
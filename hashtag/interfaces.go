@@ -0,0 +1,15 @@
+package hashtag
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type Usecase interface {
+	GetTweets(viewerID, name string, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error)
+}
+
+type Repository interface {
+	GetByName(name string) (*models.Hashtag, error)
+	SyncReferences(resourceID string, resourceType models.TagResourceType, names []string) error
+}
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// GetByName provides a mock function with given fields: name
+func (_m *Repository) GetByName(name string) (*models.Hashtag, error) {
+	ret := _m.Called(name)
+
+	var r0 *models.Hashtag
+	if rf, ok := ret.Get(0).(func(string) *models.Hashtag); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Hashtag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncReferences provides a mock function with given fields: resourceID, resourceType, names
+func (_m *Repository) SyncReferences(resourceID string, resourceType models.TagResourceType, names []string) error {
+	ret := _m.Called(resourceID, resourceType, names)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, models.TagResourceType, []string) error); ok {
+		r0 = rf(resourceID, resourceType, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,62 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Usecase is an autogenerated mock type for the Usecase type
+type Usecase struct {
+	mock.Mock
+}
+
+// GetTweets provides a mock function with given fields: viewerID, name, cursor, limit
+func (_m *Usecase) GetTweets(viewerID string, name string, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, name, cursor, limit)
+
+	var r0 []*models.Tweet
+	if rf, ok := ret.Get(0).(func(string, string, *utils.Cursor, int) []*models.Tweet); ok {
+		r0 = rf(viewerID, name, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tweet)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(viewerID, name, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, *utils.Cursor, int) error); ok {
+		r2 = rf(viewerID, name, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+type mockConstructorTestingTNewUsecase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUsecase creates a new instance of Usecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUsecase(t mockConstructorTestingTNewUsecase) *Usecase {
+	mock := &Usecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/jordyf15/tweeter-api/hashtag"
+	"github.com/jordyf15/tweeter-api/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type hashtagRepository struct {
+	DB *gorm.DB
+}
+
+func NewHashtagRepository(db *gorm.DB) hashtag.Repository {
+	return &hashtagRepository{DB: db}
+}
+
+func (repo *hashtagRepository) GetByName(name string) (*models.Hashtag, error) {
+	hashtag := &models.Hashtag{}
+
+	err := repo.DB.Table("hashtags").Where("name = ?", name).First(hashtag).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return hashtag, nil
+}
+
+// SyncReferences replaces the tag references of a resource with the given hashtag names,
+// creating the hashtags that do not exist yet. It should be called with the DB of the
+// transaction writing the resource so that both are committed together.
+func (repo *hashtagRepository) SyncReferences(resourceID string, resourceType models.TagResourceType, names []string) error {
+	err := repo.DB.Where("resource_id = ? AND resource_type = ?", resourceID, resourceType).Delete(&models.TagReference{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	hashtags := make([]*models.Hashtag, len(names))
+	for i, name := range names {
+		hashtags[i] = &models.Hashtag{ID: uuid.New().String(), Name: name}
+	}
+
+	err = repo.DB.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).Create(&hashtags).Error
+	if err != nil {
+		return err
+	}
+
+	var tagIDs []string
+	err = repo.DB.Table("hashtags").Where("name IN ?", names).Pluck("id", &tagIDs).Error
+	if err != nil {
+		return err
+	}
+
+	tagReferences := make([]*models.TagReference, len(tagIDs))
+	for i, tagID := range tagIDs {
+		tagReferences[i] = &models.TagReference{TagID: tagID, ResourceID: resourceID, ResourceType: resourceType}
+	}
+
+	return repo.DB.Create(&tagReferences).Error
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/jordyf15/tweeter-api/hashtag"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type hashtagUsecase struct {
+	hashtagRepo  hashtag.Repository
+	tweetRepo    tweet.Repository
+	tweetUsecase tweet.Usecase
+}
+
+func NewHashtagUsecase(hashtagRepo hashtag.Repository, tweetRepo tweet.Repository, tweetUsecase tweet.Usecase) hashtag.Usecase {
+	return &hashtagUsecase{hashtagRepo: hashtagRepo, tweetRepo: tweetRepo, tweetUsecase: tweetUsecase}
+}
+
+// GetTweets returns the tweets tagged with the hashtag that the viewer is allowed to see,
+// leaving out the tweets muted by the viewer. The next cursor is taken from the last tweet read
+// before the hidden tweets are dropped, so a page can come back short or even empty while more
+// pages remain, and hidden tweets are never read again on the following pages.
+func (usecase *hashtagUsecase) GetTweets(viewerID, name string, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error) {
+	_hashtag, err := usecase.hashtagRepo.GetByName(strings.ToLower(strings.TrimPrefix(name, "#")))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tweets, err := usecase.tweetRepo.GetByHashtag(_hashtag.ID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(tweets) > limit {
+		tweets = tweets[:limit]
+		lastTweet := tweets[limit-1]
+		nextCursor = utils.NewCursor(lastTweet.CreatedAt, lastTweet.ID)
+	}
+
+	tweets, err = usecase.tweetUsecase.FilterViewableTweets(viewerID, tweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tweets, err = usecase.tweetUsecase.FilterMutedTweets(viewerID, tweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = usecase.tweetUsecase.AssignUsers(tweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tweets, nextCursor, nil
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/hashtag"
+	hashtagMocks "github.com/jordyf15/tweeter-api/hashtag/mocks"
+	"github.com/jordyf15/tweeter-api/hashtag/usecase"
+	"github.com/jordyf15/tweeter-api/models"
+	tweetMocks "github.com/jordyf15/tweeter-api/tweet/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestHashtagUsecase(t *testing.T) {
+	suite.Run(t, new(hashtagUsecaseSuite))
+}
+
+type hashtagUsecaseSuite struct {
+	suite.Suite
+	usecase      hashtag.Usecase
+	hashtagRepo  *hashtagMocks.Repository
+	tweetRepo    *tweetMocks.Repository
+	tweetUsecase *tweetMocks.Usecase
+}
+
+var (
+	hutUser = &models.User{ID: "userID1", Username: "username"}
+
+	hutTweets = []*models.Tweet{
+		{ID: "tweetID1", UserID: "userID1", Description: "#golang 1", CreatedAt: time.Now()},
+		{ID: "tweetID2", UserID: "userID1", Description: "#golang 2", CreatedAt: time.Now().Add(-time.Minute)},
+		{ID: "tweetID3", UserID: "userID1", Description: "#golang 3", CreatedAt: time.Now().Add(-time.Hour)},
+	}
+)
+
+func (s *hashtagUsecaseSuite) SetupTest() {
+	s.hashtagRepo = new(hashtagMocks.Repository)
+	s.tweetRepo = new(tweetMocks.Repository)
+	s.tweetUsecase = new(tweetMocks.Usecase)
+
+	getByHashtag := func(hashtagID string, cursor *utils.Cursor, limit int) []*models.Tweet {
+		if limit > len(hutTweets) {
+			return hutTweets
+		}
+
+		return hutTweets[:limit]
+	}
+
+	s.hashtagRepo.On("GetByName", "golang").Return(&models.Hashtag{ID: "hashtagID1", Name: "golang"}, nil)
+	s.hashtagRepo.On("GetByName", mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	s.tweetRepo.On("GetByHashtag", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(getByHashtag, nil)
+	s.tweetUsecase.On("FilterViewableTweets", mock.AnythingOfType("string"), mock.AnythingOfType("[]*models.Tweet")).Return(func(viewerID string, tweets []*models.Tweet) []*models.Tweet {
+		// userID2 is protected and not followed by userID3
+		if viewerID == "userID3" {
+			return tweets[:0]
+		}
+
+		return tweets
+	}, nil)
+	s.tweetUsecase.On("FilterMutedTweets", mock.AnythingOfType("string"), mock.AnythingOfType("[]*models.Tweet")).Return(func(viewerID string, tweets []*models.Tweet) []*models.Tweet {
+		// userID4 has muted the keyword of tweetID2
+		if viewerID != "userID4" {
+			return tweets
+		}
+
+		unmutedTweets := make([]*models.Tweet, 0, len(tweets))
+		for _, _tweet := range tweets {
+			if _tweet.ID != "tweetID2" {
+				unmutedTweets = append(unmutedTweets, _tweet)
+			}
+		}
+
+		return unmutedTweets
+	}, nil)
+	s.tweetUsecase.On("AssignUsers", mock.AnythingOfType("[]*models.Tweet")).Return(nil).Run(func(args mock.Arguments) {
+		for _, _tweet := range args.Get(0).([]*models.Tweet) {
+			_tweet.User = hutUser
+		}
+	})
+
+	s.usecase = usecase.NewHashtagUsecase(s.hashtagRepo, s.tweetRepo, s.tweetUsecase)
+}
+
+func (s *hashtagUsecaseSuite) TestGetTweetsHashtagNotFound() {
+	tweets, nextCursor, err := s.usecase.GetTweets("userID2", "rust", nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), gorm.ErrRecordNotFound.Error(), err.Error())
+	assert.Nil(s.T(), tweets)
+	assert.Nil(s.T(), nextCursor)
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "GetByHashtag", 0)
+}
+
+func (s *hashtagUsecaseSuite) TestGetTweetsNormalizesName() {
+	_, _, err := s.usecase.GetTweets("userID2", "#GoLang", nil, 20)
+
+	assert.NoError(s.T(), err)
+	s.tweetRepo.AssertCalled(s.T(), "GetByHashtag", "hashtagID1", mock.Anything, 21)
+}
+
+func (s *hashtagUsecaseSuite) TestGetTweetsWithNextPage() {
+	tweets, nextCursor, err := s.usecase.GetTweets("userID2", "golang", nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 2)
+	assert.Equal(s.T(), hutUser, tweets[0].User)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), hutTweets[1].ID, nextCursor.ID)
+	s.tweetUsecase.AssertNumberOfCalls(s.T(), "AssignUsers", 1)
+}
+
+func (s *hashtagUsecaseSuite) TestGetTweetsLastPage() {
+	tweets, nextCursor, err := s.usecase.GetTweets("userID2", "golang", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 3)
+	assert.Nil(s.T(), nextCursor)
+}
+
+func (s *hashtagUsecaseSuite) TestGetTweetsHidesUnviewableTweets() {
+	tweets, _, err := s.usecase.GetTweets("userID3", "golang", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 0)
+	s.tweetUsecase.AssertCalled(s.T(), "FilterViewableTweets", "userID3", mock.AnythingOfType("[]*models.Tweet"))
+}
+
+func (s *hashtagUsecaseSuite) TestGetTweetsCursorSkipsHiddenTweets() {
+	tweets, nextCursor, err := s.usecase.GetTweets("userID3", "golang", nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 0)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), hutTweets[1].ID, nextCursor.ID)
+	assert.Equal(s.T(), hutTweets[1].CreatedAt, nextCursor.CreatedAt)
+
+	_, _, err = s.usecase.GetTweets("userID3", "golang", nextCursor, 2)
+
+	assert.NoError(s.T(), err)
+	s.tweetRepo.AssertCalled(s.T(), "GetByHashtag", "hashtagID1", nextCursor, 3)
+}
+
+func (s *hashtagUsecaseSuite) TestGetTweetsHidesMutedTweets() {
+	tweets, _, err := s.usecase.GetTweets("userID4", "golang", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 2)
+	assert.Equal(s.T(), "tweetID1", tweets[0].ID)
+	assert.Equal(s.T(), "tweetID3", tweets[1].ID)
+	s.tweetUsecase.AssertCalled(s.T(), "FilterMutedTweets", "userID4", mock.AnythingOfType("[]*models.Tweet"))
+}
@@ -0,0 +1,20 @@
+package like
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type Usecase interface {
+	Like(userID, resourceID string, resourceType models.LikeResourceType) error
+	Unlike(userID, resourceID string, resourceType models.LikeResourceType) error
+	GetLikers(viewerID, resourceID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.User, *utils.Cursor, error)
+}
+
+type Repository interface {
+	Create(like *models.Like) (bool, error)
+	Delete(like *models.Like) error
+	IsExist(userID, resourceID string, resourceType models.LikeResourceType) (bool, error)
+	GetByResource(resourceID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.Like, error)
+	GetByUserID(userID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.Like, error)
+}
@@ -0,0 +1,132 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0
+func (_m *Repository) Create(_a0 *models.Like) (bool, error) {
+	ret := _m.Called(_a0)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*models.Like) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Like) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: _a0
+func (_m *Repository) Delete(_a0 *models.Like) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Like) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByResource provides a mock function with given fields: resourceID, resourceType, cursor, limit
+func (_m *Repository) GetByResource(resourceID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.Like, error) {
+	ret := _m.Called(resourceID, resourceType, cursor, limit)
+
+	var r0 []*models.Like
+	if rf, ok := ret.Get(0).(func(string, models.LikeResourceType, *utils.Cursor, int) []*models.Like); ok {
+		r0 = rf(resourceID, resourceType, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Like)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, models.LikeResourceType, *utils.Cursor, int) error); ok {
+		r1 = rf(resourceID, resourceType, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUserID provides a mock function with given fields: userID, resourceType, cursor, limit
+func (_m *Repository) GetByUserID(userID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.Like, error) {
+	ret := _m.Called(userID, resourceType, cursor, limit)
+
+	var r0 []*models.Like
+	if rf, ok := ret.Get(0).(func(string, models.LikeResourceType, *utils.Cursor, int) []*models.Like); ok {
+		r0 = rf(userID, resourceType, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Like)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, models.LikeResourceType, *utils.Cursor, int) error); ok {
+		r1 = rf(userID, resourceType, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsExist provides a mock function with given fields: userID, resourceID, resourceType
+func (_m *Repository) IsExist(userID string, resourceID string, resourceType models.LikeResourceType) (bool, error) {
+	ret := _m.Called(userID, resourceID, resourceType)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, models.LikeResourceType) bool); ok {
+		r0 = rf(userID, resourceID, resourceType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, models.LikeResourceType) error); ok {
+		r1 = rf(userID, resourceID, resourceType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,90 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Usecase is an autogenerated mock type for the Usecase type
+type Usecase struct {
+	mock.Mock
+}
+
+// GetLikers provides a mock function with given fields: viewerID, resourceID, resourceType, cursor, limit
+func (_m *Usecase) GetLikers(viewerID string, resourceID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.User, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, resourceID, resourceType, cursor, limit)
+
+	var r0 []*models.User
+	if rf, ok := ret.Get(0).(func(string, string, models.LikeResourceType, *utils.Cursor, int) []*models.User); ok {
+		r0 = rf(viewerID, resourceID, resourceType, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, models.LikeResourceType, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(viewerID, resourceID, resourceType, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, models.LikeResourceType, *utils.Cursor, int) error); ok {
+		r2 = rf(viewerID, resourceID, resourceType, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Like provides a mock function with given fields: userID, resourceID, resourceType
+func (_m *Usecase) Like(userID string, resourceID string, resourceType models.LikeResourceType) error {
+	ret := _m.Called(userID, resourceID, resourceType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, models.LikeResourceType) error); ok {
+		r0 = rf(userID, resourceID, resourceType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unlike provides a mock function with given fields: userID, resourceID, resourceType
+func (_m *Usecase) Unlike(userID string, resourceID string, resourceType models.LikeResourceType) error {
+	ret := _m.Called(userID, resourceID, resourceType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, models.LikeResourceType) error); ok {
+		r0 = rf(userID, resourceID, resourceType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewUsecase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUsecase creates a new instance of Usecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUsecase(t mockConstructorTestingTNewUsecase) *Usecase {
+	mock := &Usecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/like"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type likeRepository struct {
+	DB *gorm.DB
+}
+
+func NewLikeRepository(db *gorm.DB) like.Repository {
+	return &likeRepository{DB: db}
+}
+
+// Create inserts the like unless it already exists. The returned bool is false when it already existed.
+func (repo *likeRepository) Create(like *models.Like) (bool, error) {
+	like.CreatedAt = time.Now()
+
+	result := repo.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(like)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (repo *likeRepository) Delete(like *models.Like) error {
+	return repo.DB.Where("user_id = ? AND resource_id = ? AND resource_type = ?", like.UserID, like.ResourceID, like.ResourceType).Delete(&models.Like{}).Error
+}
+
+func (repo *likeRepository) IsExist(userID, resourceID string, resourceType models.LikeResourceType) (bool, error) {
+	var count int64
+	err := repo.DB.Table("likes").Where("user_id = ? AND resource_id = ? AND resource_type = ?", userID, resourceID, resourceType).Count(&count).Error
+	return count > 0, err
+}
+
+func (repo *likeRepository) GetByResource(resourceID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.Like, error) {
+	likes := make([]*models.Like, 0)
+
+	query := repo.DB.Table("likes").Where("resource_id = ? AND resource_type = ?", resourceID, resourceType)
+	if cursor != nil {
+		query = query.Where("(created_at, user_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, user_id DESC").Limit(limit).Find(&likes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return likes, nil
+}
+
+func (repo *likeRepository) GetByUserID(userID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.Like, error) {
+	likes := make([]*models.Like, 0)
+
+	query := repo.DB.Table("likes").Where("user_id = ? AND resource_type = ?", userID, resourceType)
+	if cursor != nil {
+		query = query.Where("(created_at, resource_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, resource_id DESC").Limit(limit).Find(&likes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return likes, nil
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"github.com/jordyf15/tweeter-api/block"
+	"github.com/jordyf15/tweeter-api/comment"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/like"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/user"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type likeUsecase struct {
+	likeRepo     like.Repository
+	tweetRepo    tweet.Repository
+	tweetUsecase tweet.Usecase
+	commentRepo  comment.Repository
+	userRepo     user.Repository
+	blockRepo    block.Repository
+	storage      storage.Storage
+}
+
+func NewLikeUsecase(likeRepo like.Repository, tweetRepo tweet.Repository, tweetUsecase tweet.Usecase, commentRepo comment.Repository, userRepo user.Repository, blockRepo block.Repository, storage storage.Storage) like.Usecase {
+	return &likeUsecase{likeRepo: likeRepo, tweetRepo: tweetRepo, tweetUsecase: tweetUsecase, commentRepo: commentRepo, userRepo: userRepo, blockRepo: blockRepo, storage: storage}
+}
+
+// Like makes the user like the tweet or comment. Users cannot like the tweets and comments
+// of a user who has blocked them or whom they have blocked, nor anything under the tweets
+// of a protected user they do not follow.
+func (usecase *likeUsecase) Like(userID, resourceID string, resourceType models.LikeResourceType) error {
+	err := usecase.verifyViewPermission(userID, resourceID, resourceType)
+	if err != nil {
+		return err
+	}
+
+	isCreated, err := usecase.likeRepo.Create(&models.Like{UserID: userID, ResourceID: resourceID, ResourceType: resourceType})
+	if err != nil {
+		return err
+	}
+
+	if !isCreated {
+		return custom_errors.ErrAlreadyLiked
+	}
+
+	return nil
+}
+
+func (usecase *likeUsecase) Unlike(userID, resourceID string, resourceType models.LikeResourceType) error {
+	_, err := usecase.getResourceOwnerID(resourceID, resourceType)
+	if err != nil {
+		return err
+	}
+
+	isLiked, err := usecase.likeRepo.IsExist(userID, resourceID, resourceType)
+	if err != nil {
+		return err
+	}
+
+	if !isLiked {
+		return custom_errors.ErrNotLiked
+	}
+
+	return usecase.likeRepo.Delete(&models.Like{UserID: userID, ResourceID: resourceID, ResourceType: resourceType})
+}
+
+func (usecase *likeUsecase) GetLikers(viewerID, resourceID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) ([]*models.User, *utils.Cursor, error) {
+	err := usecase.verifyViewPermission(viewerID, resourceID, resourceType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	likes, err := usecase.likeRepo.GetByResource(resourceID, resourceType, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(likes) > limit {
+		likes = likes[:limit]
+		lastLike := likes[limit-1]
+		nextCursor = utils.NewCursor(lastLike.CreatedAt, lastLike.UserID)
+	}
+
+	userIDs := make([]string, 0, len(likes))
+	for _, _like := range likes {
+		userIDs = append(userIDs, _like.UserID)
+	}
+
+	usersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	likers := make([]*models.User, 0, len(likes))
+	for _, _like := range likes {
+		if _user, isExist := usersByID[_like.UserID]; isExist {
+			likers = append(likers, _user)
+		}
+	}
+
+	return likers, nextCursor, nil
+}
+
+// getResourceOwnerID returns the ID of the user who posted the tweet or comment.
+func (usecase *likeUsecase) getResourceOwnerID(resourceID string, resourceType models.LikeResourceType) (string, error) {
+	switch resourceType {
+	case models.LikeResourceTypeTweet:
+		_tweet, err := usecase.tweetRepo.GetByID(resourceID)
+		if err != nil {
+			return "", err
+		}
+
+		return _tweet.UserID, nil
+	case models.LikeResourceTypeComment:
+		_comment, err := usecase.commentRepo.GetByID(resourceID)
+		if err != nil {
+			return "", err
+		}
+
+		return _comment.UserID, nil
+	default:
+		return "", custom_errors.ErrInvalidLikeResourceType
+	}
+}
+
+// verifyViewPermission returns an error when the viewer is not allowed to see the tweet or comment.
+// A comment is only visible when its tweet is and there is no block between the viewer and its author.
+func (usecase *likeUsecase) verifyViewPermission(viewerID, resourceID string, resourceType models.LikeResourceType) error {
+	switch resourceType {
+	case models.LikeResourceTypeTweet:
+		return usecase.tweetUsecase.VerifyViewPermission(resourceID, viewerID)
+	case models.LikeResourceTypeComment:
+		_comment, err := usecase.commentRepo.GetByID(resourceID)
+		if err != nil {
+			return err
+		}
+
+		err = usecase.tweetUsecase.VerifyViewPermission(_comment.TweetID, viewerID)
+		if err != nil {
+			return err
+		}
+
+		if _comment.UserID == viewerID {
+			return nil
+		}
+
+		isBlocked, err := usecase.blockRepo.IsBlockedBetween(viewerID, _comment.UserID)
+		if err != nil {
+			return err
+		}
+
+		if isBlocked {
+			return custom_errors.ErrUserBlocked
+		}
+
+		return nil
+	default:
+		return custom_errors.ErrInvalidLikeResourceType
+	}
+}
@@ -0,0 +1,211 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	blockMocks "github.com/jordyf15/tweeter-api/block/mocks"
+	commentMocks "github.com/jordyf15/tweeter-api/comment/mocks"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/like"
+	likeMocks "github.com/jordyf15/tweeter-api/like/mocks"
+	"github.com/jordyf15/tweeter-api/like/usecase"
+	"github.com/jordyf15/tweeter-api/models"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	tweetMocks "github.com/jordyf15/tweeter-api/tweet/mocks"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestLikeUsecase(t *testing.T) {
+	suite.Run(t, new(likeUsecaseSuite))
+}
+
+type likeUsecaseSuite struct {
+	suite.Suite
+	usecase      like.Usecase
+	likeRepo     *likeMocks.Repository
+	tweetRepo    *tweetMocks.Repository
+	tweetUsecase *tweetMocks.Usecase
+	commentRepo  *commentMocks.Repository
+	userRepo     *userMocks.Repository
+	blockRepo    *blockMocks.Repository
+	storageMock  *storageMocks.Storage
+}
+
+var (
+	lutUsers = []*models.User{
+		{ID: "userID1", Username: "username1"},
+		{ID: "userID2", Username: "username2"},
+		{ID: "userID3", Username: "username3"},
+	}
+
+	lutLikes = []*models.Like{
+		{UserID: "userID1", ResourceID: "tweetID1", ResourceType: models.LikeResourceTypeTweet, CreatedAt: time.Now()},
+		{UserID: "userID2", ResourceID: "tweetID1", ResourceType: models.LikeResourceTypeTweet, CreatedAt: time.Now().Add(-time.Minute)},
+		{UserID: "userID3", ResourceID: "tweetID1", ResourceType: models.LikeResourceTypeTweet, CreatedAt: time.Now().Add(-time.Hour)},
+	}
+)
+
+func (s *likeUsecaseSuite) SetupTest() {
+	s.likeRepo = new(likeMocks.Repository)
+	s.tweetRepo = new(tweetMocks.Repository)
+	s.tweetUsecase = new(tweetMocks.Usecase)
+	s.commentRepo = new(commentMocks.Repository)
+	s.userRepo = new(userMocks.Repository)
+	s.blockRepo = new(blockMocks.Repository)
+	s.storageMock = new(storageMocks.Storage)
+
+	isExist := func(userID, resourceID string, resourceType models.LikeResourceType) bool {
+		return userID == "userID2"
+	}
+
+	// userID4 has blocked userID1
+	isBlockedBetween := func(userID, otherUserID string) bool {
+		return (userID == "userID1" && otherUserID == "userID4") || (userID == "userID4" && otherUserID == "userID1")
+	}
+
+	getByResource := func(resourceID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) []*models.Like {
+		if limit > len(lutLikes) {
+			return lutLikes
+		}
+
+		return lutLikes[:limit]
+	}
+
+	getByIDs := func(ids []string) []*models.User {
+		users := make([]*models.User, 0)
+		for _, _user := range lutUsers {
+			for _, id := range ids {
+				if _user.ID == id {
+					users = append(users, _user)
+				}
+			}
+		}
+
+		return users
+	}
+
+	s.likeRepo.On("Create", mock.AnythingOfType("*models.Like")).Return(func(like *models.Like) bool { return like.UserID != "userID2" }, nil)
+	s.likeRepo.On("Delete", mock.AnythingOfType("*models.Like")).Return(nil)
+	s.likeRepo.On("IsExist", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("models.LikeResourceType")).Return(isExist, nil)
+	s.likeRepo.On("GetByResource", mock.AnythingOfType("string"), mock.AnythingOfType("models.LikeResourceType"), mock.Anything, mock.AnythingOfType("int")).Return(getByResource, nil)
+	s.tweetRepo.On("GetByID", "tweetID1").Return(&models.Tweet{ID: "tweetID1", UserID: "userID3"}, nil)
+	s.tweetRepo.On("GetByID", "tweetID3").Return(&models.Tweet{ID: "tweetID3", UserID: "userID4"}, nil)
+	s.tweetRepo.On("GetByID", mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	// tweetID3 is posted by userID4 and tweetID4 by a protected user userID1 does not follow
+	s.tweetUsecase.On("VerifyViewPermission", "tweetID1", mock.AnythingOfType("string")).Return(nil)
+	s.tweetUsecase.On("VerifyViewPermission", "tweetID3", "userID1").Return(custom_errors.ErrUserBlocked)
+	s.tweetUsecase.On("VerifyViewPermission", "tweetID4", "userID1").Return(custom_errors.ErrTweetProtected)
+	s.tweetUsecase.On("VerifyViewPermission", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(gorm.ErrRecordNotFound)
+	s.commentRepo.On("GetByID", "commentID2").Return(&models.Comment{ID: "commentID2", TweetID: "tweetID1", UserID: "userID4"}, nil)
+	s.commentRepo.On("GetByID", mock.AnythingOfType("string")).Return(&models.Comment{ID: "commentID1", TweetID: "tweetID1", UserID: "userID3"}, nil)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getByIDs, nil)
+	s.blockRepo.On("IsBlockedBetween", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isBlockedBetween, nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
+
+	s.usecase = usecase.NewLikeUsecase(s.likeRepo, s.tweetRepo, s.tweetUsecase, s.commentRepo, s.userRepo, s.blockRepo, s.storageMock)
+}
+
+func (s *likeUsecaseSuite) TestLikeTweetNotFound() {
+	err := s.usecase.Like("userID1", "tweetID2", models.LikeResourceTypeTweet)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), gorm.ErrRecordNotFound.Error(), err.Error())
+	s.likeRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *likeUsecaseSuite) TestLikeTweetOfBlockedUser() {
+	err := s.usecase.Like("userID1", "tweetID3", models.LikeResourceTypeTweet)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.likeRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *likeUsecaseSuite) TestLikeTweetOfProtectedUser() {
+	err := s.usecase.Like("userID1", "tweetID4", models.LikeResourceTypeTweet)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+	s.likeRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *likeUsecaseSuite) TestLikeCommentOfBlockedUser() {
+	err := s.usecase.Like("userID1", "commentID2", models.LikeResourceTypeComment)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.tweetUsecase.AssertCalled(s.T(), "VerifyViewPermission", "tweetID1", "userID1")
+	s.likeRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *likeUsecaseSuite) TestLikeAlreadyLiked() {
+	err := s.usecase.Like("userID2", "tweetID1", models.LikeResourceTypeTweet)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrAlreadyLiked.Error(), err.Error())
+}
+
+func (s *likeUsecaseSuite) TestLikeTweetSuccessful() {
+	err := s.usecase.Like("userID1", "tweetID1", models.LikeResourceTypeTweet)
+
+	assert.NoError(s.T(), err)
+	s.likeRepo.AssertCalled(s.T(), "Create", &models.Like{UserID: "userID1", ResourceID: "tweetID1", ResourceType: models.LikeResourceTypeTweet})
+}
+
+func (s *likeUsecaseSuite) TestLikeCommentSuccessful() {
+	err := s.usecase.Like("userID1", "commentID1", models.LikeResourceTypeComment)
+
+	assert.NoError(s.T(), err)
+	s.commentRepo.AssertNumberOfCalls(s.T(), "GetByID", 1)
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "GetByID", 0)
+	s.likeRepo.AssertCalled(s.T(), "Create", &models.Like{UserID: "userID1", ResourceID: "commentID1", ResourceType: models.LikeResourceTypeComment})
+}
+
+func (s *likeUsecaseSuite) TestUnlikeNotLiked() {
+	err := s.usecase.Unlike("userID1", "tweetID1", models.LikeResourceTypeTweet)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrNotLiked.Error(), err.Error())
+	s.likeRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *likeUsecaseSuite) TestUnlikeSuccessful() {
+	err := s.usecase.Unlike("userID2", "commentID1", models.LikeResourceTypeComment)
+
+	assert.NoError(s.T(), err)
+	s.likeRepo.AssertNumberOfCalls(s.T(), "Delete", 1)
+}
+
+func (s *likeUsecaseSuite) TestGetLikersWithNextPage() {
+	users, nextCursor, err := s.usecase.GetLikers("userID1", "tweetID1", models.LikeResourceTypeTweet, nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*models.User{lutUsers[0], lutUsers[1]}, users)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), lutLikes[1].UserID, nextCursor.ID)
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToUser", 2)
+}
+
+func (s *likeUsecaseSuite) TestGetLikersLastPage() {
+	users, nextCursor, err := s.usecase.GetLikers("userID1", "tweetID1", models.LikeResourceTypeTweet, nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), users, 3)
+	assert.Nil(s.T(), nextCursor)
+}
+
+func (s *likeUsecaseSuite) TestGetLikersOfProtectedTweet() {
+	users, nextCursor, err := s.usecase.GetLikers("userID1", "tweetID4", models.LikeResourceTypeTweet, nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+	assert.Nil(s.T(), users)
+	assert.Nil(s.T(), nextCursor)
+	s.likeRepo.AssertNumberOfCalls(s.T(), "GetByResource", 0)
+}
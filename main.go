@@ -10,6 +10,7 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	"github.com/jordyf15/tweeter-api/group"
 	"github.com/jordyf15/tweeter-api/middlewares"
 	"github.com/jordyf15/tweeter-api/token/repository"
 	"github.com/jordyf15/tweeter-api/token/usecase"
@@ -52,6 +53,10 @@ func main() {
 
 	router.Use(authMiddleware.AuthenticateJWT)
 
+	if expiration, err := time.ParseDuration(os.Getenv("GROUP_INVITATION_EXPIRATION")); err == nil && expiration > 0 {
+		group.InvitationExpiration = expiration
+	}
+
 	router.MaxMultipartMemory = 10 << 20
 	initializeRoutes()
 	if os.Getenv("ROUTER_PORT") != "" {
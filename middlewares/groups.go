@@ -0,0 +1,40 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/group_member"
+	"github.com/jordyf15/tweeter-api/models"
+	"gorm.io/gorm"
+)
+
+type GroupMiddleware struct {
+	usecase group_member.Usecase
+}
+
+func NewGroupMiddleware(usecase group_member.Usecase) *GroupMiddleware {
+	return &GroupMiddleware{usecase: usecase}
+}
+
+// EnsureGroupPermission only lets the request through when the current user's role in the group of
+// the path allows the action.
+func (middleware *GroupMiddleware) EnsureGroupPermission(action models.GroupAction) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := middleware.usecase.VerifyPermission(c.Param("group_id"), c.MustGet("current_user_id").(string), action)
+		switch err {
+		case nil:
+			c.Next()
+		case gorm.ErrRecordNotFound:
+			c.AbortWithStatusJSON(http.StatusNotFound,
+				custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrRecordNotFound}})
+		case custom_errors.ErrGroupPermissionDenied:
+			c.AbortWithStatusJSON(http.StatusForbidden,
+				custom_errors.MultipleErrors{Errors: []error{err}})
+		default:
+			c.AbortWithStatusJSON(http.StatusInternalServerError,
+				custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrUnknownErrorOccured}})
+		}
+	}
+}
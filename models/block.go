@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+type Block struct {
+	BlockerID string `json:"-" gorm:"primaryKey"`
+	BlockedID string `json:"-" gorm:"primaryKey"`
+
+	CreatedAt time.Time `json:"-"`
+}
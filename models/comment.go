@@ -0,0 +1,62 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+)
+
+const (
+	minCommentLength = 1
+	maxCommentLength = 280
+)
+
+type Comment struct {
+	ID        string    `json:"id" gorm:"primaryKey"`
+	TweetID   string    `json:"tweet_id"`
+	UserID    string    `json:"-"`
+	User      *User     `gorm:"-" json:"user"`
+	Comment   string    `json:"comment" gorm:"type:text"`
+	Images    Images    `gorm:"type:jsonb" json:"images"`
+	LikeCount uint      `gorm:"default:0" json:"like_count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (comment *Comment) VerifyFields() []error {
+	errors := make([]error, 0)
+	if len(comment.Comment) < minCommentLength {
+		errors = append(errors, custom_errors.ErrCommentTooShort)
+	}
+
+	if len([]rune(comment.Comment)) > maxCommentLength {
+		errors = append(errors, custom_errors.ErrCommentTooLong)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+func (comment *Comment) MarshalJSON() ([]byte, error) {
+	type Alias Comment
+	newStruct := &struct {
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+		*Alias
+	}{
+		CreatedAt: comment.CreatedAt.Format("2006-01-02T15:04:05-0700"),
+		UpdatedAt: comment.UpdatedAt.Format("2006-01-02T15:04:05-0700"),
+		Alias:     (*Alias)(comment),
+	}
+
+	return json.Marshal(newStruct)
+}
+
+func (comment *Comment) ImagePath(image *Image) string {
+	return fmt.Sprintf("uploads/comments/%s/%s", comment.ID, image.Filename)
+}
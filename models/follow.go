@@ -9,3 +9,10 @@ type Follow struct {
 
 	CreatedAt time.Time `json:"-"`
 }
+
+// Relationship is the follow state between the current user and another user.
+type Relationship struct {
+	IsFollowing       bool `json:"is_following"`
+	IsFollowRequested bool `json:"is_follow_requested"`
+	FollowsYou        bool `json:"follows_you"`
+}
@@ -14,6 +14,13 @@ const (
 	maxNameLength = 255
 )
 
+type GroupSort string
+
+const (
+	GroupSortCreatedAt   GroupSort = "created_at"
+	GroupSortMemberCount GroupSort = "member_count"
+)
+
 type Group struct {
 	ID          string `json:"id" gorm:"primaryKey"`
 	Name        string `json:"name" gorm:"type:varchar(255)"`
@@ -24,6 +31,9 @@ type Group struct {
 	Creator     *User     `gorm:"-" json:"creator"`
 	CreatedAt   time.Time `json:"created_at"`
 	IsOpen      bool      `json:"is_open"`
+
+	// Role is the role of the user whose groups are being listed.
+	Role GroupMemberRole `gorm:"-" json:"role,omitempty"`
 }
 
 func (group *Group) VerifyFields() []error {
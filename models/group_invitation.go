@@ -0,0 +1,35 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// GroupInvitation is a pending invitation for a user to join a group. Accepting it adds the invitee
+// as a member even when the group is closed.
+type GroupInvitation struct {
+	ID        string    `json:"id" gorm:"primaryKey"`
+	GroupID   string    `json:"-"`
+	Group     *Group    `json:"group,omitempty" gorm:"-"`
+	InviterID string    `json:"-"`
+	Inviter   *User     `json:"inviter,omitempty" gorm:"-"`
+	InviteeID string    `json:"-"`
+	Invitee   *User     `json:"invitee,omitempty" gorm:"-"`
+	CreatedAt time.Time `json:"-"`
+	ExpiresAt time.Time `json:"-" gorm:"-"`
+}
+
+func (invitation *GroupInvitation) MarshalJSON() ([]byte, error) {
+	type Alias GroupInvitation
+	newStruct := &struct {
+		CreatedAt string `json:"created_at"`
+		ExpiresAt string `json:"expires_at"`
+		*Alias
+	}{
+		CreatedAt: invitation.CreatedAt.Format("2006-01-02T15:04:05-0700"),
+		ExpiresAt: invitation.ExpiresAt.Format("2006-01-02T15:04:05-0700"),
+		Alias:     (*Alias)(invitation),
+	}
+
+	return json.Marshal(newStruct)
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// GroupJoinRequest is a pending request of a user to join a closed group.
+type GroupJoinRequest struct {
+	ID          string    `json:"id" gorm:"primaryKey"`
+	GroupID     string    `json:"-"`
+	RequesterID string    `json:"-"`
+	Requester   *User     `json:"requester,omitempty" gorm:"-"`
+	CreatedAt   time.Time `json:"-"`
+}
+
+func (request *GroupJoinRequest) MarshalJSON() ([]byte, error) {
+	type Alias GroupJoinRequest
+	newStruct := &struct {
+		CreatedAt string `json:"created_at"`
+		*Alias
+	}{
+		CreatedAt: request.CreatedAt.Format("2006-01-02T15:04:05-0700"),
+		Alias:     (*Alias)(request),
+	}
+
+	return json.Marshal(newStruct)
+}
@@ -10,9 +10,71 @@ const (
 	GroupMemberRoleAdmin     GroupMemberRole = "admin"
 )
 
+// GroupAction is something a member may do in their group, depending on their role.
+type GroupAction string
+
+const (
+	GroupActionEditGroup          GroupAction = "edit_group"
+	GroupActionManageJoinRequests GroupAction = "manage_join_requests"
+	GroupActionInviteMember       GroupAction = "invite_member"
+	GroupActionChangeMemberRole   GroupAction = "change_member_role"
+	GroupActionRemoveMember       GroupAction = "remove_member"
+)
+
+// groupPermissions lists the actions each role is allowed to do.
+var groupPermissions = map[GroupMemberRole][]GroupAction{
+	GroupMemberRoleAdmin: {
+		GroupActionEditGroup,
+		GroupActionManageJoinRequests,
+		GroupActionInviteMember,
+		GroupActionChangeMemberRole,
+		GroupActionRemoveMember,
+	},
+	GroupMemberRoleModerator: {
+		GroupActionManageJoinRequests,
+		GroupActionInviteMember,
+		GroupActionRemoveMember,
+	},
+	GroupMemberRoleMember: {},
+}
+
+// groupManageableRoles lists the roles of the other members each role can act on, e.g. moderators can
+// remove members but not admins.
+var groupManageableRoles = map[GroupMemberRole][]GroupMemberRole{
+	GroupMemberRoleAdmin:     {GroupMemberRoleModerator, GroupMemberRoleMember},
+	GroupMemberRoleModerator: {GroupMemberRoleMember},
+	GroupMemberRoleMember:    {},
+}
+
+func (role GroupMemberRole) IsValid() bool {
+	_, isExist := groupPermissions[role]
+	return isExist
+}
+
+func (role GroupMemberRole) Can(action GroupAction) bool {
+	for _, allowedAction := range groupPermissions[role] {
+		if allowedAction == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (role GroupMemberRole) CanManage(otherRole GroupMemberRole) bool {
+	for _, manageableRole := range groupManageableRoles[role] {
+		if manageableRole == otherRole {
+			return true
+		}
+	}
+
+	return false
+}
+
 type GroupMember struct {
 	GroupID  string `json:"-" gorm:"primaryKey"`
 	MemberID string `json:"-" gorm:"primaryKey"`
+	Member   *User  `json:"member,omitempty" gorm:"-"`
 
 	Role GroupMemberRole `json:"role"`
 
@@ -0,0 +1,20 @@
+package models
+
+type TagResourceType string
+
+const (
+	TagResourceTypeTweet   TagResourceType = "tweet"
+	TagResourceTypeComment TagResourceType = "comment"
+)
+
+type Hashtag struct {
+	ID       string `json:"id" gorm:"primaryKey"`
+	Name     string `json:"name"`
+	RefCount uint   `json:"ref_count" gorm:"default:0"`
+}
+
+type TagReference struct {
+	TagID        string          `gorm:"primaryKey"`
+	ResourceID   string          `gorm:"primaryKey"`
+	ResourceType TagResourceType `gorm:"primaryKey"`
+}
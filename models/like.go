@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type LikeResourceType string
+
+const (
+	LikeResourceTypeTweet   LikeResourceType = "tweet"
+	LikeResourceTypeComment LikeResourceType = "comment"
+)
+
+type Like struct {
+	UserID       string           `json:"-" gorm:"primaryKey"`
+	ResourceID   string           `json:"-" gorm:"primaryKey"`
+	ResourceType LikeResourceType `json:"-" gorm:"primaryKey"`
+
+	CreatedAt time.Time `json:"-"`
+}
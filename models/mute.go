@@ -0,0 +1,94 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+const maxMuteKeywordLength = 100
+
+type MuteDuration string
+
+const (
+	MuteDurationForever MuteDuration = ""
+	MuteDurationDay     MuteDuration = "24h"
+	MuteDurationWeek    MuteDuration = "7d"
+	MuteDurationMonth   MuteDuration = "30d"
+)
+
+// Mute hides the tweets of the muted user, or the tweets containing the keyword, from the user.
+// Keywords starting with # mute the hashtag.
+type Mute struct {
+	ID          string     `json:"id" gorm:"primaryKey"`
+	UserID      string     `json:"-"`
+	MutedUserID *string    `json:"-"`
+	MutedUser   *User      `json:"muted_user,omitempty" gorm:"-"`
+	Keyword     *string    `json:"keyword,omitempty"`
+	ExpiresAt   *time.Time `json:"-"`
+	CreatedAt   time.Time  `json:"-"`
+}
+
+func (mute *Mute) VerifyFields() []error {
+	errors := make([]error, 0)
+	if (mute.MutedUserID == nil) == (mute.Keyword == nil) {
+		errors = append(errors, custom_errors.ErrMuteTargetInvalid)
+	} else if mute.Keyword != nil && (len(*mute.Keyword) == 0 || *mute.Keyword == "#") {
+		errors = append(errors, custom_errors.ErrMuteTargetInvalid)
+	} else if mute.Keyword != nil && utf8.RuneCountInString(*mute.Keyword) > maxMuteKeywordLength {
+		errors = append(errors, custom_errors.ErrMuteKeywordTooLong)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+func (mute *Mute) IsExpired(now time.Time) bool {
+	return mute.ExpiresAt != nil && !mute.ExpiresAt.After(now)
+}
+
+// MatchesText reports whether the text contains the muted keyword, ignoring case.
+// Hashtag keywords only match the same hashtag.
+func (mute *Mute) MatchesText(text string) bool {
+	if mute.Keyword == nil {
+		return false
+	}
+
+	if strings.HasPrefix(*mute.Keyword, "#") {
+		for _, hashtag := range utils.ExtractHashtags(text) {
+			if hashtag == (*mute.Keyword)[1:] {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(text), *mute.Keyword)
+}
+
+func (mute *Mute) MarshalJSON() ([]byte, error) {
+	type Alias Mute
+	newStruct := &struct {
+		ExpiresAt *string `json:"expires_at"`
+		CreatedAt string  `json:"created_at"`
+		*Alias
+	}{
+		CreatedAt: mute.CreatedAt.Format("2006-01-02T15:04:05-0700"),
+		Alias:     (*Alias)(mute),
+	}
+
+	if mute.ExpiresAt != nil {
+		expiresAt := mute.ExpiresAt.Format("2006-01-02T15:04:05-0700")
+		newStruct.ExpiresAt = &expiresAt
+	}
+
+	return json.Marshal(newStruct)
+}
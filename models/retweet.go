@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+type Retweet struct {
+	TweetID string `json:"-" gorm:"primaryKey"`
+	UserID  string `json:"-" gorm:"primaryKey"`
+
+	CreatedAt time.Time `json:"-"`
+}
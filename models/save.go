@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+type Save struct {
+	UserID  string `json:"-" gorm:"primaryKey"`
+	TweetID string `json:"-" gorm:"primaryKey"`
+
+	CreatedAt time.Time `json:"-"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TimelineEntry is a tweet shown on a timeline, either because it was posted or because it was retweeted.
+// UserID is the user who posted or retweeted it.
+type TimelineEntry struct {
+	ID          string  `json:"id"`
+	TweetID     string  `json:"-"`
+	UserID      string  `json:"-"`
+	RetweeterID *string `json:"-"`
+
+	Tweet       *Tweet `gorm:"-" json:"tweet"`
+	RetweetedBy *User  `gorm:"-" json:"retweeted_by"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (entry *TimelineEntry) MarshalJSON() ([]byte, error) {
+	type Alias TimelineEntry
+	newStruct := &struct {
+		CreatedAt string `json:"created_at"`
+		*Alias
+	}{
+		CreatedAt: entry.CreatedAt.Format("2006-01-02T15:04:05-0700"),
+		Alias:     (*Alias)(entry),
+	}
+
+	return json.Marshal(newStruct)
+}
@@ -0,0 +1,15 @@
+package models
+
+type TrendWindow string
+
+const (
+	TrendWindowHour TrendWindow = "1h"
+	TrendWindowDay  TrendWindow = "24h"
+	TrendWindowWeek TrendWindow = "7d"
+)
+
+type Trend struct {
+	Name  string  `json:"name"`
+	Count uint    `json:"count"`
+	Score float64 `json:"-"`
+}
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+)
+
+const (
+	minTweetDescriptionLength = 1
+	maxTweetDescriptionLength = 280
+)
+
+type TweetReplyConstraint string
+
+const (
+	TweetReplyConstraintEveryone      TweetReplyConstraint = "everyone"
+	TweetReplyConstraintFollowingOnly TweetReplyConstraint = "following-only"
+)
+
+type UserTweetFilter string
+
+const (
+	UserTweetFilterTweets  UserTweetFilter = "tweets"
+	UserTweetFilterReplies UserTweetFilter = "replies"
+	UserTweetFilterMedia   UserTweetFilter = "media"
+	UserTweetFilterLikes   UserTweetFilter = "likes"
+)
+
+type Tweet struct {
+	ID              string               `json:"id" gorm:"primaryKey"`
+	UserID          string               `json:"-"`
+	User            *User                `gorm:"-" json:"user"`
+	Description     string               `json:"description" gorm:"type:text"`
+	Images          Images               `gorm:"type:jsonb" json:"images"`
+	ReplyConstraint TweetReplyConstraint `json:"reply_constraint"`
+	CommentCount    uint                 `gorm:"default:0" json:"comment_count"`
+	LikeCount       uint                 `gorm:"default:0" json:"like_count"`
+	RetweetCount    uint                 `gorm:"default:0" json:"retweet_count"`
+	SaveCount       uint                 `gorm:"default:0" json:"save_count"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+func (tweet *Tweet) VerifyFields() []error {
+	errors := make([]error, 0)
+	if len(tweet.Description) < minTweetDescriptionLength {
+		errors = append(errors, custom_errors.ErrTweetDescriptionTooShort)
+	}
+
+	if len([]rune(tweet.Description)) > maxTweetDescriptionLength {
+		errors = append(errors, custom_errors.ErrTweetDescriptionTooLong)
+	}
+
+	switch tweet.ReplyConstraint {
+	case TweetReplyConstraintEveryone, TweetReplyConstraintFollowingOnly:
+		break
+	default:
+		errors = append(errors, custom_errors.ErrTweetReplyConstraintInvalid)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+func (tweet *Tweet) MarshalJSON() ([]byte, error) {
+	type Alias Tweet
+	newStruct := &struct {
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+		*Alias
+	}{
+		CreatedAt: tweet.CreatedAt.Format("2006-01-02T15:04:05-0700"),
+		UpdatedAt: tweet.UpdatedAt.Format("2006-01-02T15:04:05-0700"),
+		Alias:     (*Alias)(tweet),
+	}
+
+	return json.Marshal(newStruct)
+}
+
+func (tweet *Tweet) ImagePath(image *Image) string {
+	return fmt.Sprintf("uploads/tweets/%s/%s", tweet.ID, image.Filename)
+}
@@ -47,7 +47,7 @@ type User struct {
 	ID                string `json:"id" gorm:"primaryKey"`
 	Fullname          string `json:"fullname" gorm:"type:varchar(255)"`
 	Username          string `json:"username" gorm:"type:varchar(30)"`
-	Email             string `json:"email" gorm:"type:varchar(255)"`
+	Email             string `json:"-" gorm:"type:varchar(255)"`
 	Description       string `json:"description" gorm:"type:text"`
 	Password          string `gorm:"-" json:"-"`
 	EncryptedPassword string `gorm:"type:text" json:"-"`
@@ -58,10 +58,28 @@ type User struct {
 	FollowerCount  uint `gorm:"default:0" json:"follower_count"`
 	FollowingCount uint `gorm:"default:0" json:"following_count"`
 
+	// IsProtected makes the user's tweets visible to their followers only, and following them requires their approval.
+	IsProtected bool `gorm:"default:false" json:"is_protected"`
+
 	CreatedAt time.Time `json:"-"`
 	UpdatedAt time.Time `json:"-"`
 }
 
+// UserProfile is a user as seen by the current viewer.
+// Email is only filled when the viewer is the owner of the profile.
+type UserProfile struct {
+	*User
+	Email       string `json:"email,omitempty"`
+	IsFollowing bool   `json:"is_following"`
+	FollowsYou  bool   `json:"follows_you"`
+}
+
+// CurrentUser is the signed in user as seen by themselves, along with their email.
+type CurrentUser struct {
+	*User
+	Email string `json:"email"`
+}
+
 func (user *User) VerifyFields() []error {
 	errors := make([]error, 0)
 	if !emailRegex.MatchString(user.Email) {
@@ -168,6 +186,7 @@ func (user *User) AssignPointers(columns []string) []interface{} {
 		"background_image": &user.BackgroundImage,
 		"follower_count":   &user.FollowerCount,
 		"following_count":  &user.FollowingCount,
+		"is_protected":     &user.IsProtected,
 		"created_at":       &user.CreatedAt,
 		"updated_at":       &user.UpdatedAt,
 	}
@@ -0,0 +1,21 @@
+package mute
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type Usecase interface {
+	Mute(mute *models.Mute, duration models.MuteDuration) (*models.Mute, error)
+	Unmute(mute *models.Mute) error
+	GetMutes(userID string, cursor *utils.Cursor, limit int) ([]*models.Mute, *utils.Cursor, error)
+}
+
+type Repository interface {
+	Create(mute *models.Mute) error
+	Delete(id string) error
+	GetByMutedUserID(userID, mutedUserID string) (*models.Mute, error)
+	GetByKeyword(userID, keyword string) (*models.Mute, error)
+	GetByUserID(userID string, cursor *utils.Cursor, limit int) ([]*models.Mute, error)
+	GetActiveByUserID(userID string) ([]*models.Mute, error)
+}
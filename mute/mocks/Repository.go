@@ -0,0 +1,150 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0
+func (_m *Repository) Create(_a0 *models.Mute) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Mute) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *Repository) Delete(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActiveByUserID provides a mock function with given fields: userID
+func (_m *Repository) GetActiveByUserID(userID string) ([]*models.Mute, error) {
+	ret := _m.Called(userID)
+
+	var r0 []*models.Mute
+	if rf, ok := ret.Get(0).(func(string) []*models.Mute); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Mute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByKeyword provides a mock function with given fields: userID, keyword
+func (_m *Repository) GetByKeyword(userID string, keyword string) (*models.Mute, error) {
+	ret := _m.Called(userID, keyword)
+
+	var r0 *models.Mute
+	if rf, ok := ret.Get(0).(func(string, string) *models.Mute); ok {
+		r0 = rf(userID, keyword)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Mute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userID, keyword)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByMutedUserID provides a mock function with given fields: userID, mutedUserID
+func (_m *Repository) GetByMutedUserID(userID string, mutedUserID string) (*models.Mute, error) {
+	ret := _m.Called(userID, mutedUserID)
+
+	var r0 *models.Mute
+	if rf, ok := ret.Get(0).(func(string, string) *models.Mute); ok {
+		r0 = rf(userID, mutedUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Mute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userID, mutedUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUserID provides a mock function with given fields: userID, cursor, limit
+func (_m *Repository) GetByUserID(userID string, cursor *utils.Cursor, limit int) ([]*models.Mute, error) {
+	ret := _m.Called(userID, cursor, limit)
+
+	var r0 []*models.Mute
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.Mute); ok {
+		r0 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Mute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) error); ok {
+		r1 = rf(userID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Usecase is an autogenerated mock type for the Usecase type
+type Usecase struct {
+	mock.Mock
+}
+
+// GetMutes provides a mock function with given fields: userID, cursor, limit
+func (_m *Usecase) GetMutes(userID string, cursor *utils.Cursor, limit int) ([]*models.Mute, *utils.Cursor, error) {
+	ret := _m.Called(userID, cursor, limit)
+
+	var r0 []*models.Mute
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.Mute); ok {
+		r0 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Mute)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, *utils.Cursor, int) error); ok {
+		r2 = rf(userID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Mute provides a mock function with given fields: _a0, duration
+func (_m *Usecase) Mute(_a0 *models.Mute, duration models.MuteDuration) (*models.Mute, error) {
+	ret := _m.Called(_a0, duration)
+
+	var r0 *models.Mute
+	if rf, ok := ret.Get(0).(func(*models.Mute, models.MuteDuration) *models.Mute); ok {
+		r0 = rf(_a0, duration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Mute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Mute, models.MuteDuration) error); ok {
+		r1 = rf(_a0, duration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unmute provides a mock function with given fields: _a0
+func (_m *Usecase) Unmute(_a0 *models.Mute) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Mute) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewUsecase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUsecase creates a new instance of Usecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUsecase(t mockConstructorTestingTNewUsecase) *Usecase {
+	mock := &Usecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
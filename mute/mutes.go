@@ -0,0 +1,55 @@
+package mute
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+)
+
+// Filter hides the content muted by a user.
+type Filter struct {
+	userID       string
+	isMutedUser  map[string]bool
+	keywordMutes []*models.Mute
+}
+
+// GetFilter loads the active mutes of the user into a Filter.
+func GetFilter(muteRepo Repository, userID string) (*Filter, error) {
+	mutes, err := muteRepo.GetActiveByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &Filter{userID: userID, isMutedUser: make(map[string]bool, len(mutes)), keywordMutes: make([]*models.Mute, 0, len(mutes))}
+	for _, _mute := range mutes {
+		if _mute.MutedUserID != nil {
+			filter.isMutedUser[*_mute.MutedUserID] = true
+		} else {
+			filter.keywordMutes = append(filter.keywordMutes, _mute)
+		}
+	}
+
+	return filter, nil
+}
+
+func (filter *Filter) IsUserMuted(userID string) bool {
+	return filter.isMutedUser[userID]
+}
+
+// IsTweetMuted reports whether the author of the tweet is muted or the tweet contains a muted keyword.
+// The user's own tweets are never muted.
+func (filter *Filter) IsTweetMuted(tweet *models.Tweet) bool {
+	if tweet.UserID == filter.userID {
+		return false
+	}
+
+	if filter.isMutedUser[tweet.UserID] {
+		return true
+	}
+
+	for _, _mute := range filter.keywordMutes {
+		if _mute.MatchesText(tweet.Description) {
+			return true
+		}
+	}
+
+	return false
+}
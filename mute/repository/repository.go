@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/mute"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+)
+
+type muteRepository struct {
+	DB *gorm.DB
+}
+
+func NewMuteRepository(db *gorm.DB) mute.Repository {
+	return &muteRepository{DB: db}
+}
+
+func (repo *muteRepository) Create(mute *models.Mute) error {
+	return repo.DB.Create(mute).Error
+}
+
+func (repo *muteRepository) Delete(id string) error {
+	return repo.DB.Where("id = ?", id).Delete(&models.Mute{}).Error
+}
+
+// GetByMutedUserID returns the user's mute of the muted user, including an expired one.
+func (repo *muteRepository) GetByMutedUserID(userID, mutedUserID string) (*models.Mute, error) {
+	mute := &models.Mute{}
+	err := repo.DB.Where("user_id = ? AND muted_user_id = ?", userID, mutedUserID).First(mute).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return mute, nil
+}
+
+// GetByKeyword returns the user's mute of the keyword, including an expired one.
+func (repo *muteRepository) GetByKeyword(userID, keyword string) (*models.Mute, error) {
+	mute := &models.Mute{}
+	err := repo.DB.Where("user_id = ? AND keyword = ?", userID, keyword).First(mute).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return mute, nil
+}
+
+// GetByUserID returns a page of the user's mutes that have not expired, most recent first.
+func (repo *muteRepository) GetByUserID(userID string, cursor *utils.Cursor, limit int) ([]*models.Mute, error) {
+	mutes := make([]*models.Mute, 0)
+
+	query := repo.DB.Table("mutes").Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, time.Now())
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&mutes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return mutes, nil
+}
+
+// GetActiveByUserID returns all of the user's mutes that have not expired.
+func (repo *muteRepository) GetActiveByUserID(userID string) ([]*models.Mute, error) {
+	mutes := make([]*models.Mute, 0)
+	err := repo.DB.Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, time.Now()).Find(&mutes).Error
+	return mutes, err
+}
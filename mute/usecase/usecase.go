@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/mute"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/user"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+)
+
+type muteUsecase struct {
+	muteRepo mute.Repository
+	userRepo user.Repository
+	storage  storage.Storage
+}
+
+func NewMuteUsecase(muteRepo mute.Repository, userRepo user.Repository, storage storage.Storage) mute.Usecase {
+	return &muteUsecase{muteRepo: muteRepo, userRepo: userRepo, storage: storage}
+}
+
+// Mute hides the muted user or keyword from the user's timeline until the duration has passed.
+// The muted user is not told about it and can still follow and interact with the user.
+func (usecase *muteUsecase) Mute(_mute *models.Mute, duration models.MuteDuration) (*models.Mute, error) {
+	errors := make([]error, 0)
+
+	normalizeKeyword(_mute)
+
+	validateFieldErrors := _mute.VerifyFields()
+	if len(validateFieldErrors) > 0 {
+		errors = append(errors, validateFieldErrors...)
+	}
+
+	now := time.Now()
+	switch duration {
+	case models.MuteDurationForever:
+		_mute.ExpiresAt = nil
+	case models.MuteDurationDay:
+		expiresAt := now.Add(24 * time.Hour)
+		_mute.ExpiresAt = &expiresAt
+	case models.MuteDurationWeek:
+		expiresAt := now.AddDate(0, 0, 7)
+		_mute.ExpiresAt = &expiresAt
+	case models.MuteDurationMonth:
+		expiresAt := now.AddDate(0, 0, 30)
+		_mute.ExpiresAt = &expiresAt
+	default:
+		errors = append(errors, custom_errors.ErrInvalidMuteDuration)
+	}
+
+	if len(errors) > 0 {
+		return nil, &custom_errors.MultipleErrors{Errors: errors}
+	}
+
+	if _mute.MutedUserID != nil {
+		if *_mute.MutedUserID == _mute.UserID {
+			return nil, custom_errors.ErrMuteSelf
+		}
+
+		isExist, err := usecase.userRepo.IsIDExist(*_mute.MutedUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isExist {
+			return nil, custom_errors.ErrRecordNotFound
+		}
+	}
+
+	existingMute, err := usecase.getExistingMute(_mute)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingMute != nil {
+		if !existingMute.IsExpired(now) {
+			return nil, custom_errors.ErrAlreadyMuted
+		}
+
+		err = usecase.muteRepo.Delete(existingMute.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_mute.ID = uuid.New().String()
+	_mute.CreatedAt = now
+
+	err = usecase.muteRepo.Create(_mute)
+	if err != nil {
+		return nil, err
+	}
+
+	err = usecase.assignMutedUsers([]*models.Mute{_mute})
+	if err != nil {
+		return nil, err
+	}
+
+	return _mute, nil
+}
+
+func (usecase *muteUsecase) Unmute(_mute *models.Mute) error {
+	normalizeKeyword(_mute)
+
+	validateFieldErrors := _mute.VerifyFields()
+	if len(validateFieldErrors) > 0 {
+		return &custom_errors.MultipleErrors{Errors: validateFieldErrors}
+	}
+
+	existingMute, err := usecase.getExistingMute(_mute)
+	if err != nil {
+		return err
+	}
+
+	if existingMute == nil || existingMute.IsExpired(time.Now()) {
+		return custom_errors.ErrNotMuted
+	}
+
+	return usecase.muteRepo.Delete(existingMute.ID)
+}
+
+func (usecase *muteUsecase) GetMutes(userID string, cursor *utils.Cursor, limit int) ([]*models.Mute, *utils.Cursor, error) {
+	mutes, err := usecase.muteRepo.GetByUserID(userID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(mutes) > limit {
+		mutes = mutes[:limit]
+		lastMute := mutes[limit-1]
+		nextCursor = utils.NewCursor(lastMute.CreatedAt, lastMute.ID)
+	}
+
+	err = usecase.assignMutedUsers(mutes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mutes, nextCursor, nil
+}
+
+// getExistingMute returns the user's mute with the same target, or nil when there is none.
+func (usecase *muteUsecase) getExistingMute(_mute *models.Mute) (*models.Mute, error) {
+	var existingMute *models.Mute
+	var err error
+
+	if _mute.MutedUserID != nil {
+		existingMute, err = usecase.muteRepo.GetByMutedUserID(_mute.UserID, *_mute.MutedUserID)
+	} else {
+		existingMute, err = usecase.muteRepo.GetByKeyword(_mute.UserID, *_mute.Keyword)
+	}
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return existingMute, nil
+}
+
+func (usecase *muteUsecase) assignMutedUsers(mutes []*models.Mute) error {
+	userIDs := make([]string, 0, len(mutes))
+	for _, _mute := range mutes {
+		if _mute.MutedUserID != nil {
+			userIDs = append(userIDs, *_mute.MutedUserID)
+		}
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	usersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, _mute := range mutes {
+		if _mute.MutedUserID != nil {
+			_mute.MutedUser = usersByID[*_mute.MutedUserID]
+		}
+	}
+
+	return nil
+}
+
+// normalizeKeyword trims and lowercases the muted keyword so it matches regardless of case.
+func normalizeKeyword(_mute *models.Mute) {
+	if _mute.Keyword != nil {
+		keyword := strings.ToLower(strings.TrimSpace(*_mute.Keyword))
+		_mute.Keyword = &keyword
+	}
+}
@@ -0,0 +1,189 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/mute"
+	muteMocks "github.com/jordyf15/tweeter-api/mute/mocks"
+	"github.com/jordyf15/tweeter-api/mute/usecase"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestMuteUsecase(t *testing.T) {
+	suite.Run(t, new(muteUsecaseSuite))
+}
+
+type muteUsecaseSuite struct {
+	suite.Suite
+	usecase     mute.Usecase
+	muteRepo    *muteMocks.Repository
+	userRepo    *userMocks.Repository
+	storageMock *storageMocks.Storage
+}
+
+var (
+	mutMutedUserID = "userID2"
+	mutKeyword     = "spoiler"
+	mutExpiredAt   = time.Now().Add(-time.Hour)
+
+	mutMutes = []*models.Mute{
+		{ID: "muteID1", UserID: "userID1", MutedUserID: &mutMutedUserID, CreatedAt: time.Now()},
+		{ID: "muteID2", UserID: "userID1", Keyword: &mutKeyword, CreatedAt: time.Now().Add(-time.Minute)},
+		{ID: "muteID3", UserID: "userID1", Keyword: &mutKeyword, CreatedAt: time.Now().Add(-time.Hour)},
+	}
+)
+
+func (s *muteUsecaseSuite) SetupTest() {
+	s.muteRepo = new(muteMocks.Repository)
+	s.userRepo = new(userMocks.Repository)
+	s.storageMock = new(storageMocks.Storage)
+
+	isIDExist := func(userID string) bool {
+		return userID != "userID3"
+	}
+
+	getMutes := func(userID string, cursor *utils.Cursor, limit int) []*models.Mute {
+		if limit > len(mutMutes) {
+			return mutMutes
+		}
+
+		return mutMutes[:limit]
+	}
+
+	getUsersByIDs := func(ids []string) []*models.User {
+		users := make([]*models.User, 0)
+		for _, id := range ids {
+			users = append(users, &models.User{ID: id, Email: id + "@mail.com"})
+		}
+
+		return users
+	}
+
+	// userID1 has muted userID2, the keyword "spoiler" and the keyword "#go" until an hour ago
+	s.muteRepo.On("GetByMutedUserID", "userID1", "userID2").Return(mutMutes[0], nil)
+	s.muteRepo.On("GetByMutedUserID", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	s.muteRepo.On("GetByKeyword", "userID1", "spoiler").Return(mutMutes[1], nil)
+	s.muteRepo.On("GetByKeyword", "userID1", "#go").Return(&models.Mute{ID: "muteID4", UserID: "userID1", ExpiresAt: &mutExpiredAt}, nil)
+	s.muteRepo.On("GetByKeyword", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	s.muteRepo.On("GetByUserID", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(getMutes, nil)
+	s.muteRepo.On("Create", mock.AnythingOfType("*models.Mute")).Return(nil)
+	s.muteRepo.On("Delete", mock.AnythingOfType("string")).Return(nil)
+	s.userRepo.On("IsIDExist", mock.AnythingOfType("string")).Return(isIDExist, nil)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getUsersByIDs, nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
+
+	s.usecase = usecase.NewMuteUsecase(s.muteRepo, s.userRepo, s.storageMock)
+}
+
+func (s *muteUsecaseSuite) TestMuteInvalidFields() {
+	userID := "userID4"
+	keyword := "keyword"
+
+	result, err := s.usecase.Mute(&models.Mute{UserID: "userID1", MutedUserID: &userID, Keyword: &keyword}, "1y")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+
+	multipleErr, ok := err.(*custom_errors.MultipleErrors)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), []error{custom_errors.ErrMuteTargetInvalid, custom_errors.ErrInvalidMuteDuration}, multipleErr.Errors)
+	s.muteRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *muteUsecaseSuite) TestMuteSelf() {
+	userID := "userID1"
+
+	result, err := s.usecase.Mute(&models.Mute{UserID: "userID1", MutedUserID: &userID}, models.MuteDurationForever)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), custom_errors.ErrMuteSelf.Error(), err.Error())
+}
+
+func (s *muteUsecaseSuite) TestMuteUserNotFound() {
+	userID := "userID3"
+
+	result, err := s.usecase.Mute(&models.Mute{UserID: "userID1", MutedUserID: &userID}, models.MuteDurationForever)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+}
+
+func (s *muteUsecaseSuite) TestMuteAlreadyMuted() {
+	keyword := " Spoiler "
+
+	result, err := s.usecase.Mute(&models.Mute{UserID: "userID1", Keyword: &keyword}, models.MuteDurationDay)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), custom_errors.ErrAlreadyMuted.Error(), err.Error())
+	s.muteRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *muteUsecaseSuite) TestMuteReplacesExpiredMute() {
+	keyword := "#Go"
+
+	result, err := s.usecase.Mute(&models.Mute{UserID: "userID1", Keyword: &keyword}, models.MuteDurationWeek)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "#go", *result.Keyword)
+	assert.NotNil(s.T(), result.ExpiresAt)
+	assert.WithinDuration(s.T(), time.Now().AddDate(0, 0, 7), *result.ExpiresAt, time.Minute)
+	s.muteRepo.AssertCalled(s.T(), "Delete", "muteID4")
+	s.muteRepo.AssertNumberOfCalls(s.T(), "Create", 1)
+}
+
+func (s *muteUsecaseSuite) TestMuteUserSuccessful() {
+	userID := "userID4"
+
+	result, err := s.usecase.Mute(&models.Mute{UserID: "userID1", MutedUserID: &userID}, models.MuteDurationForever)
+
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), result.ID)
+	assert.Nil(s.T(), result.ExpiresAt)
+	assert.Equal(s.T(), "userID4", result.MutedUser.ID)
+	assert.Empty(s.T(), result.MutedUser.Email)
+	s.muteRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+	s.muteRepo.AssertNumberOfCalls(s.T(), "Create", 1)
+}
+
+func (s *muteUsecaseSuite) TestUnmuteNotMuted() {
+	keyword := "#go"
+
+	err := s.usecase.Unmute(&models.Mute{UserID: "userID1", Keyword: &keyword})
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrNotMuted.Error(), err.Error())
+	s.muteRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *muteUsecaseSuite) TestUnmuteSuccessful() {
+	userID := "userID2"
+
+	err := s.usecase.Unmute(&models.Mute{UserID: "userID1", MutedUserID: &userID})
+
+	assert.NoError(s.T(), err)
+	s.muteRepo.AssertCalled(s.T(), "Delete", "muteID1")
+}
+
+func (s *muteUsecaseSuite) TestGetMutesWithNextPage() {
+	mutes, nextCursor, err := s.usecase.GetMutes("userID1", nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), mutes, 2)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), "muteID2", nextCursor.ID)
+	assert.Equal(s.T(), "userID2", mutes[0].MutedUser.ID)
+	assert.Nil(s.T(), mutes[1].MutedUser)
+	s.muteRepo.AssertCalled(s.T(), "GetByUserID", "userID1", mock.Anything, 3)
+}
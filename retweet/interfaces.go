@@ -0,0 +1,19 @@
+package retweet
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type Usecase interface {
+	Retweet(userID, tweetID string) error
+	Unretweet(userID, tweetID string) error
+	GetRetweeters(viewerID, tweetID string, cursor *utils.Cursor, limit int) ([]*models.User, *utils.Cursor, error)
+}
+
+type Repository interface {
+	Create(retweet *models.Retweet) (bool, error)
+	Delete(retweet *models.Retweet) error
+	IsExist(userID, tweetID string) (bool, error)
+	GetByTweetID(tweetID string, cursor *utils.Cursor, limit int) ([]*models.Retweet, error)
+}
@@ -0,0 +1,90 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Usecase is an autogenerated mock type for the Usecase type
+type Usecase struct {
+	mock.Mock
+}
+
+// GetRetweeters provides a mock function with given fields: viewerID, tweetID, cursor, limit
+func (_m *Usecase) GetRetweeters(viewerID string, tweetID string, cursor *utils.Cursor, limit int) ([]*models.User, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, tweetID, cursor, limit)
+
+	var r0 []*models.User
+	if rf, ok := ret.Get(0).(func(string, string, *utils.Cursor, int) []*models.User); ok {
+		r0 = rf(viewerID, tweetID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(viewerID, tweetID, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, *utils.Cursor, int) error); ok {
+		r2 = rf(viewerID, tweetID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Retweet provides a mock function with given fields: userID, tweetID
+func (_m *Usecase) Retweet(userID string, tweetID string) error {
+	ret := _m.Called(userID, tweetID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, tweetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unretweet provides a mock function with given fields: userID, tweetID
+func (_m *Usecase) Unretweet(userID string, tweetID string) error {
+	ret := _m.Called(userID, tweetID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, tweetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewUsecase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUsecase creates a new instance of Usecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUsecase(t mockConstructorTestingTNewUsecase) *Usecase {
+	mock := &Usecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
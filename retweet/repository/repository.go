@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/retweet"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type retweetRepository struct {
+	DB *gorm.DB
+}
+
+func NewRetweetRepository(db *gorm.DB) retweet.Repository {
+	return &retweetRepository{DB: db}
+}
+
+// Create inserts the retweet unless it already exists. The returned bool is false when it already existed.
+func (repo *retweetRepository) Create(retweet *models.Retweet) (bool, error) {
+	retweet.CreatedAt = time.Now()
+
+	result := repo.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(retweet)
+	return result.RowsAffected > 0, result.Error
+}
+
+// Delete removes the retweet and fills in the creation time of the removed row.
+func (repo *retweetRepository) Delete(retweet *models.Retweet) error {
+	return repo.DB.Clauses(clause.Returning{}).Where("tweet_id = ? AND user_id = ?", retweet.TweetID, retweet.UserID).Delete(retweet).Error
+}
+
+func (repo *retweetRepository) IsExist(userID, tweetID string) (bool, error) {
+	var count int64
+	err := repo.DB.Table("retweets").Where("tweet_id = ? AND user_id = ?", tweetID, userID).Count(&count).Error
+	return count > 0, err
+}
+
+func (repo *retweetRepository) GetByTweetID(tweetID string, cursor *utils.Cursor, limit int) ([]*models.Retweet, error) {
+	retweets := make([]*models.Retweet, 0)
+
+	query := repo.DB.Table("retweets").Where("tweet_id = ?", tweetID)
+	if cursor != nil {
+		query = query.Where("(created_at, user_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, user_id DESC").Limit(limit).Find(&retweets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return retweets, nil
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/retweet"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/timeline"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/user"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+)
+
+type retweetUsecase struct {
+	retweetRepo     retweet.Repository
+	tweetRepo       tweet.Repository
+	tweetUsecase    tweet.Usecase
+	userRepo        user.Repository
+	timelineUsecase timeline.Usecase
+	storage         storage.Storage
+}
+
+func NewRetweetUsecase(retweetRepo retweet.Repository, tweetRepo tweet.Repository, tweetUsecase tweet.Usecase, userRepo user.Repository, timelineUsecase timeline.Usecase, storage storage.Storage) retweet.Usecase {
+	return &retweetUsecase{retweetRepo: retweetRepo, tweetRepo: tweetRepo, tweetUsecase: tweetUsecase, userRepo: userRepo, timelineUsecase: timelineUsecase, storage: storage}
+}
+
+// Retweet makes the user retweet the tweet. Users cannot retweet the tweets of a user who
+// has blocked them or whom they have blocked.
+func (usecase *retweetUsecase) Retweet(userID, tweetID string) error {
+	err := usecase.tweetUsecase.VerifyViewPermission(tweetID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return custom_errors.ErrRetweetTweetNotFound
+	} else if err != nil {
+		return err
+	}
+
+	_retweet := &models.Retweet{TweetID: tweetID, UserID: userID}
+	isCreated, err := usecase.retweetRepo.Create(_retweet)
+	if err != nil {
+		return err
+	}
+
+	if !isCreated {
+		return custom_errors.ErrAlreadyRetweeted
+	}
+
+	retweeter, err := usecase.userRepo.GetByID(userID)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+
+	err = usecase.timelineUsecase.PushEntry(retweeter, &models.TimelineEntry{ID: tweetID + ":" + userID, TweetID: tweetID, UserID: userID, RetweeterID: &userID, CreatedAt: _retweet.CreatedAt})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	return nil
+}
+
+func (usecase *retweetUsecase) Unretweet(userID, tweetID string) error {
+	_, err := usecase.tweetRepo.GetByID(tweetID)
+	if err != nil {
+		return err
+	}
+
+	isRetweeted, err := usecase.retweetRepo.IsExist(userID, tweetID)
+	if err != nil {
+		return err
+	}
+
+	if !isRetweeted {
+		return custom_errors.ErrNotRetweeted
+	}
+
+	_retweet := &models.Retweet{TweetID: tweetID, UserID: userID}
+	err = usecase.retweetRepo.Delete(_retweet)
+	if err != nil {
+		return err
+	}
+
+	retweeter, err := usecase.userRepo.GetByID(userID)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+
+	err = usecase.timelineUsecase.RemoveEntry(retweeter, &models.TimelineEntry{ID: tweetID + ":" + userID, TweetID: tweetID, UserID: userID, RetweeterID: &userID, CreatedAt: _retweet.CreatedAt})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	return nil
+}
+
+func (usecase *retweetUsecase) GetRetweeters(viewerID, tweetID string, cursor *utils.Cursor, limit int) ([]*models.User, *utils.Cursor, error) {
+	err := usecase.tweetUsecase.VerifyViewPermission(tweetID, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	retweets, err := usecase.retweetRepo.GetByTweetID(tweetID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(retweets) > limit {
+		retweets = retweets[:limit]
+		lastRetweet := retweets[limit-1]
+		nextCursor = utils.NewCursor(lastRetweet.CreatedAt, lastRetweet.UserID)
+	}
+
+	userIDs := make([]string, 0, len(retweets))
+	for _, _retweet := range retweets {
+		userIDs = append(userIDs, _retweet.UserID)
+	}
+
+	usersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	retweeters := make([]*models.User, 0, len(retweets))
+	for _, _retweet := range retweets {
+		if _user, isExist := usersByID[_retweet.UserID]; isExist {
+			retweeters = append(retweeters, _user)
+		}
+	}
+
+	return retweeters, nextCursor, nil
+}
@@ -0,0 +1,177 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/retweet"
+	retweetMocks "github.com/jordyf15/tweeter-api/retweet/mocks"
+	"github.com/jordyf15/tweeter-api/retweet/usecase"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	timelineMocks "github.com/jordyf15/tweeter-api/timeline/mocks"
+	tweetMocks "github.com/jordyf15/tweeter-api/tweet/mocks"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestRetweetUsecase(t *testing.T) {
+	suite.Run(t, new(retweetUsecaseSuite))
+}
+
+type retweetUsecaseSuite struct {
+	suite.Suite
+	usecase         retweet.Usecase
+	retweetRepo     *retweetMocks.Repository
+	tweetRepo       *tweetMocks.Repository
+	tweetUsecase    *tweetMocks.Usecase
+	userRepo        *userMocks.Repository
+	timelineUsecase *timelineMocks.Usecase
+	storageMock     *storageMocks.Storage
+}
+
+var (
+	rutUsers = []*models.User{
+		{ID: "userID1", Username: "username1"},
+		{ID: "userID2", Username: "username2"},
+	}
+
+	rutRetweets = []*models.Retweet{
+		{TweetID: "tweetID1", UserID: "userID1", CreatedAt: time.Now()},
+		{TweetID: "tweetID1", UserID: "userID2", CreatedAt: time.Now().Add(-time.Minute)},
+	}
+)
+
+func (s *retweetUsecaseSuite) SetupTest() {
+	s.retweetRepo = new(retweetMocks.Repository)
+	s.tweetRepo = new(tweetMocks.Repository)
+	s.tweetUsecase = new(tweetMocks.Usecase)
+	s.userRepo = new(userMocks.Repository)
+	s.timelineUsecase = new(timelineMocks.Usecase)
+	s.storageMock = new(storageMocks.Storage)
+
+	isExist := func(userID, tweetID string) bool {
+		return userID == "userID2"
+	}
+
+	getByTweetID := func(tweetID string, cursor *utils.Cursor, limit int) []*models.Retweet {
+		if limit > len(rutRetweets) {
+			return rutRetweets
+		}
+
+		return rutRetweets[:limit]
+	}
+
+	s.retweetRepo.On("Create", mock.AnythingOfType("*models.Retweet")).Return(func(retweet *models.Retweet) bool { return retweet.UserID != "userID2" }, nil)
+	s.retweetRepo.On("Delete", mock.AnythingOfType("*models.Retweet")).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.Retweet).CreatedAt = rutRetweets[1].CreatedAt
+	}).Return(nil)
+	s.retweetRepo.On("IsExist", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isExist, nil)
+	s.retweetRepo.On("GetByTweetID", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(getByTweetID, nil)
+	s.tweetRepo.On("GetByID", "tweetID1").Return(&models.Tweet{ID: "tweetID1", UserID: "userID2"}, nil)
+	s.tweetRepo.On("GetByID", mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	s.tweetUsecase.On("VerifyViewPermission", "tweetID1", mock.AnythingOfType("string")).Return(nil)
+	s.tweetUsecase.On("VerifyViewPermission", "tweetID3", mock.AnythingOfType("string")).Return(custom_errors.ErrUserBlocked)
+	s.tweetUsecase.On("VerifyViewPermission", "tweetID4", mock.AnythingOfType("string")).Return(custom_errors.ErrTweetProtected)
+	s.tweetUsecase.On("VerifyViewPermission", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(gorm.ErrRecordNotFound)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(rutUsers, nil)
+	s.userRepo.On("GetByID", mock.AnythingOfType("string")).Return(rutUsers[0], nil)
+	s.timelineUsecase.On("PushEntry", mock.AnythingOfType("*models.User"), mock.AnythingOfType("*models.TimelineEntry")).Return(nil)
+	s.timelineUsecase.On("RemoveEntry", mock.AnythingOfType("*models.User"), mock.AnythingOfType("*models.TimelineEntry")).Return(nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
+
+	s.usecase = usecase.NewRetweetUsecase(s.retweetRepo, s.tweetRepo, s.tweetUsecase, s.userRepo, s.timelineUsecase, s.storageMock)
+}
+
+func (s *retweetUsecaseSuite) TestRetweetDeletedTweet() {
+	err := s.usecase.Retweet("userID1", "tweetID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrRetweetTweetNotFound.Error(), err.Error())
+	s.retweetRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *retweetUsecaseSuite) TestRetweetBlocked() {
+	err := s.usecase.Retweet("userID1", "tweetID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.retweetRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *retweetUsecaseSuite) TestRetweetOfProtectedUser() {
+	err := s.usecase.Retweet("userID1", "tweetID4")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+	s.retweetRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *retweetUsecaseSuite) TestRetweetTwice() {
+	err := s.usecase.Retweet("userID2", "tweetID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrAlreadyRetweeted.Error(), err.Error())
+	s.timelineUsecase.AssertNumberOfCalls(s.T(), "PushEntry", 0)
+}
+
+func (s *retweetUsecaseSuite) TestRetweetSuccessful() {
+	err := s.usecase.Retweet("userID1", "tweetID1")
+
+	assert.NoError(s.T(), err)
+	s.retweetRepo.AssertCalled(s.T(), "Create", &models.Retweet{TweetID: "tweetID1", UserID: "userID1"})
+
+	retweeterID := "userID1"
+	s.timelineUsecase.AssertCalled(s.T(), "PushEntry", rutUsers[0], &models.TimelineEntry{ID: "tweetID1:userID1", TweetID: "tweetID1", UserID: "userID1", RetweeterID: &retweeterID})
+}
+
+func (s *retweetUsecaseSuite) TestUnretweetNotRetweeted() {
+	err := s.usecase.Unretweet("userID1", "tweetID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrNotRetweeted.Error(), err.Error())
+	s.retweetRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+	s.timelineUsecase.AssertNumberOfCalls(s.T(), "RemoveEntry", 0)
+}
+
+func (s *retweetUsecaseSuite) TestUnretweetSuccessful() {
+	err := s.usecase.Unretweet("userID2", "tweetID1")
+
+	assert.NoError(s.T(), err)
+	s.retweetRepo.AssertNumberOfCalls(s.T(), "Delete", 1)
+	retweeterID := "userID2"
+	s.timelineUsecase.AssertCalled(s.T(), "RemoveEntry", rutUsers[0], &models.TimelineEntry{ID: "tweetID1:userID2", TweetID: "tweetID1", UserID: "userID2", RetweeterID: &retweeterID, CreatedAt: rutRetweets[1].CreatedAt})
+}
+
+func (s *retweetUsecaseSuite) TestGetRetweetersWithNextPage() {
+	users, nextCursor, err := s.usecase.GetRetweeters("userID1", "tweetID1", nil, 1)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*models.User{rutUsers[0]}, users)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), rutRetweets[0].UserID, nextCursor.ID)
+}
+
+func (s *retweetUsecaseSuite) TestGetRetweetersLastPage() {
+	users, nextCursor, err := s.usecase.GetRetweeters("userID1", "tweetID1", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), users, 2)
+	assert.Nil(s.T(), nextCursor)
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToUser", 2)
+}
+
+func (s *retweetUsecaseSuite) TestGetRetweetersOfProtectedTweet() {
+	users, nextCursor, err := s.usecase.GetRetweeters("userID1", "tweetID4", nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+	assert.Nil(s.T(), users)
+	assert.Nil(s.T(), nextCursor)
+	s.retweetRepo.AssertNumberOfCalls(s.T(), "GetByTweetID", 0)
+}
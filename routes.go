@@ -1,16 +1,42 @@
 package main
 
 import (
+	br "github.com/jordyf15/tweeter-api/block/repository"
+	bu "github.com/jordyf15/tweeter-api/block/usecase"
+	cr "github.com/jordyf15/tweeter-api/comment/repository"
+	cu "github.com/jordyf15/tweeter-api/comment/usecase"
 	"github.com/jordyf15/tweeter-api/controllers"
 	fr "github.com/jordyf15/tweeter-api/follow/repository"
 	fu "github.com/jordyf15/tweeter-api/follow/usecase"
 	gr "github.com/jordyf15/tweeter-api/group/repository"
 	gu "github.com/jordyf15/tweeter-api/group/usecase"
+	gir "github.com/jordyf15/tweeter-api/group_invitation/repository"
+	gjr "github.com/jordyf15/tweeter-api/group_join_request/repository"
 	grr "github.com/jordyf15/tweeter-api/group_member/repository"
+	gru "github.com/jordyf15/tweeter-api/group_member/usecase"
+	hr "github.com/jordyf15/tweeter-api/hashtag/repository"
+	hu "github.com/jordyf15/tweeter-api/hashtag/usecase"
+	lr "github.com/jordyf15/tweeter-api/like/repository"
+	lu "github.com/jordyf15/tweeter-api/like/usecase"
 	"github.com/jordyf15/tweeter-api/middlewares"
+	"github.com/jordyf15/tweeter-api/models"
+	mr "github.com/jordyf15/tweeter-api/mute/repository"
+	mu "github.com/jordyf15/tweeter-api/mute/usecase"
+	rtr "github.com/jordyf15/tweeter-api/retweet/repository"
+	rtu "github.com/jordyf15/tweeter-api/retweet/usecase"
+	sr "github.com/jordyf15/tweeter-api/save/repository"
+	su "github.com/jordyf15/tweeter-api/save/usecase"
 	"github.com/jordyf15/tweeter-api/storage"
+	sgr "github.com/jordyf15/tweeter-api/suggestion/repository"
+	sgu "github.com/jordyf15/tweeter-api/suggestion/usecase"
+	tlr "github.com/jordyf15/tweeter-api/timeline/repository"
+	tlu "github.com/jordyf15/tweeter-api/timeline/usecase"
 	tr "github.com/jordyf15/tweeter-api/token/repository"
 	tu "github.com/jordyf15/tweeter-api/token/usecase"
+	trr "github.com/jordyf15/tweeter-api/trend/repository"
+	tru "github.com/jordyf15/tweeter-api/trend/usecase"
+	twr "github.com/jordyf15/tweeter-api/tweet/repository"
+	twu "github.com/jordyf15/tweeter-api/tweet/usecase"
 	ur "github.com/jordyf15/tweeter-api/user/repository"
 	uu "github.com/jordyf15/tweeter-api/user/usecase"
 )
@@ -23,26 +49,120 @@ func initializeRoutes() {
 	followRepo := fr.NewFollowRepo(db)
 	groupMemberRepo := grr.NewGroupMemberRepository(db)
 	groupRepo := gr.NewGroupRepository(db)
+	groupJoinRequestRepo := gjr.NewGroupJoinRequestRepository(db)
+	groupInvitationRepo := gir.NewGroupInvitationRepository(db)
+	tweetRepo := twr.NewTweetRepository(db)
+	commentRepo := cr.NewCommentRepository(db)
+	likeRepo := lr.NewLikeRepository(db)
+	retweetRepo := rtr.NewRetweetRepository(db)
+	saveRepo := sr.NewSaveRepository(db)
+	hashtagRepo := hr.NewHashtagRepository(db)
+	trendRepo := trr.NewTrendRepository(redisClient)
+	timelineRepo := tlr.NewTimelineRepository(redisClient)
+	suggestionRepo := sgr.NewSuggestionRepository(db, redisClient)
+	blockRepo := br.NewBlockRepository(db)
+	muteRepo := mr.NewMuteRepository(db)
 
 	tokenUsecase := tu.NewTokenUsecase(tokenRepo)
-	userUsecase := uu.NewUserUsecase(userRepo, tokenRepo, _storage)
-	followUsecase := fu.NewFollowUsecase(followRepo, userRepo)
-	groupUsecase := gu.NewGroupUsecase(groupRepo, groupMemberRepo, userRepo, _storage)
+	userUsecase := uu.NewUserUsecase(userRepo, tokenRepo, followRepo, timelineRepo, _storage)
+	followUsecase := fu.NewFollowUsecase(followRepo, userRepo, blockRepo, timelineRepo, _storage)
+	groupUsecase := gu.NewGroupUsecase(groupRepo, groupMemberRepo, groupJoinRequestRepo, groupInvitationRepo, userRepo, blockRepo, _storage)
+	groupMemberUsecase := gru.NewGroupMemberUsecase(groupMemberRepo, groupRepo, userRepo, _storage)
+	timelineUsecase := tlu.NewTimelineUsecase(timelineRepo, tweetRepo, followRepo, userRepo, blockRepo, muteRepo, _storage)
+	tweetUsecase := twu.NewTweetUsecase(tweetRepo, followRepo, userRepo, likeRepo, blockRepo, muteRepo, trendRepo, timelineUsecase, _storage)
+	commentUsecase := cu.NewCommentUsecase(commentRepo, tweetRepo, tweetUsecase, userRepo, _storage)
+	likeUsecase := lu.NewLikeUsecase(likeRepo, tweetRepo, tweetUsecase, commentRepo, userRepo, blockRepo, _storage)
+	retweetUsecase := rtu.NewRetweetUsecase(retweetRepo, tweetRepo, tweetUsecase, userRepo, timelineUsecase, _storage)
+	saveUsecase := su.NewSaveUsecase(saveRepo, tweetRepo, tweetUsecase)
+	hashtagUsecase := hu.NewHashtagUsecase(hashtagRepo, tweetRepo, tweetUsecase)
+	trendUsecase := tru.NewTrendUsecase(trendRepo)
+	suggestionUsecase := sgu.NewSuggestionUsecase(suggestionRepo, followRepo, userRepo, blockRepo, _storage)
+	blockUsecase := bu.NewBlockUsecase(blockRepo, userRepo, timelineRepo)
+	muteUsecase := mu.NewMuteUsecase(muteRepo, userRepo, _storage)
 
 	tokenController := controllers.NewTokenController(tokenUsecase)
 	userController := controllers.NewUsersController(userUsecase)
 	followController := controllers.NewFollowsController(followUsecase)
 	groupController := controllers.NewGroupsController(groupUsecase)
+	groupMemberController := controllers.NewGroupMembersController(groupMemberUsecase)
+	tweetController := controllers.NewTweetsController(tweetUsecase, commentUsecase)
+	commentController := controllers.NewCommentsController(commentUsecase)
+	likeController := controllers.NewLikesController(likeUsecase)
+	retweetController := controllers.NewRetweetsController(retweetUsecase)
+	saveController := controllers.NewSavesController(saveUsecase)
+	hashtagController := controllers.NewHashtagsController(hashtagUsecase)
+	trendController := controllers.NewTrendsController(trendUsecase)
+	timelineController := controllers.NewTimelinesController(timelineUsecase)
+	suggestionController := controllers.NewSuggestionsController(suggestionUsecase)
+	blockController := controllers.NewBlocksController(blockUsecase)
+	muteController := controllers.NewMutesController(muteUsecase)
+
+	groupMiddleware := middlewares.NewGroupMiddleware(groupMemberUsecase)
 
 	router.POST("register", userController.Register)
 	router.POST("login", userController.Login)
 
+	router.GET("users/suggestions", suggestionController.GetSuggestions)
+	router.GET("users/:user_id", userController.GetUser)
+	router.GET("users/by-username/:username", userController.GetUserByUsername)
 	router.POST("users/:user_id/password/change", middlewares.EnsureCurrentUserIDMatchesPath, userController.ChangeUserPassword)
 	router.PATCH("users/:user_id", middlewares.EnsureCurrentUserIDMatchesPath, userController.EditUserProfile)
 	router.POST("users/:user_id/follow", followController.FollowUser)
 	router.DELETE("users/:user_id/follow", followController.UnfollowUser)
+	router.POST("users/:user_id/block", blockController.BlockUser)
+	router.DELETE("users/:user_id/block", blockController.UnblockUser)
+	router.GET("users/:user_id/mutes", middlewares.EnsureCurrentUserIDMatchesPath, muteController.GetMutes)
+	router.POST("users/:user_id/mutes", middlewares.EnsureCurrentUserIDMatchesPath, muteController.CreateMute)
+	router.DELETE("users/:user_id/mutes", middlewares.EnsureCurrentUserIDMatchesPath, muteController.DeleteMute)
+	router.GET("users/:user_id/followers", followController.GetFollowers)
+	router.GET("users/:user_id/followers/you-know", followController.GetFollowersYouKnow)
+	router.GET("users/:user_id/following", followController.GetFollowings)
+	router.GET("users/:user_id/follow-requests", middlewares.EnsureCurrentUserIDMatchesPath, followController.GetFollowRequests)
+	router.POST("users/:user_id/follow-requests/:requester_id", middlewares.EnsureCurrentUserIDMatchesPath, followController.ApproveFollowRequest)
+	router.DELETE("users/:user_id/follow-requests/:requester_id", middlewares.EnsureCurrentUserIDMatchesPath, followController.DenyFollowRequest)
+	router.GET("users/:user_id/saves", middlewares.EnsureCurrentUserIDMatchesPath, saveController.GetSavedTweets)
+	router.GET("users/:user_id/tweets", tweetController.GetUserTweets)
+	router.GET("users/:user_id/groups", groupController.GetUserGroups)
+	router.GET("users/:user_id/group-invitations", middlewares.EnsureCurrentUserIDMatchesPath, groupController.GetInvitations)
 
+	router.GET("groups", groupController.GetGroups)
 	router.POST("groups", groupController.CreateGroup)
+	router.GET("groups/:group_id", groupController.GetGroup)
+	router.PATCH("groups/:group_id", groupMiddleware.EnsureGroupPermission(models.GroupActionEditGroup), groupController.EditGroup)
+	router.POST("groups/:group_id/join", groupController.JoinGroup)
+	router.DELETE("groups/:group_id/members/me", groupController.LeaveGroup)
+	router.PATCH("groups/:group_id/members/:member_id", groupMiddleware.EnsureGroupPermission(models.GroupActionChangeMemberRole), groupMemberController.UpdateMemberRole)
+	router.DELETE("groups/:group_id/members/:member_id", groupMiddleware.EnsureGroupPermission(models.GroupActionRemoveMember), groupMemberController.RemoveMember)
+	router.GET("groups/:group_id/join-requests", groupMiddleware.EnsureGroupPermission(models.GroupActionManageJoinRequests), groupController.GetJoinRequests)
+	router.POST("groups/:group_id/join-requests/:join_request_id", groupMiddleware.EnsureGroupPermission(models.GroupActionManageJoinRequests), groupController.ApproveJoinRequest)
+	router.DELETE("groups/:group_id/join-requests/:join_request_id", groupMiddleware.EnsureGroupPermission(models.GroupActionManageJoinRequests), groupController.RejectJoinRequest)
+	router.POST("groups/:group_id/invitations", groupMiddleware.EnsureGroupPermission(models.GroupActionInviteMember), groupController.InviteUser)
+	router.POST("groups/:group_id/invitations/:invitation_id", groupController.AcceptInvitation)
+	router.DELETE("groups/:group_id/invitations/:invitation_id", groupController.DeclineInvitation)
+
+	router.POST("tweets", tweetController.CreateTweet)
+	router.GET("tweets/:tweet_id", tweetController.GetTweet)
+	router.DELETE("tweets/:tweet_id", tweetController.DeleteTweet)
+	router.POST("tweets/:tweet_id/comments", commentController.CreateComment)
+	router.GET("tweets/:tweet_id/comments", commentController.GetComments)
+	router.DELETE("tweets/:tweet_id/comments/:comment_id", commentController.DeleteComment)
+	router.POST("tweets/:tweet_id/like", likeController.LikeTweet)
+	router.DELETE("tweets/:tweet_id/like", likeController.UnlikeTweet)
+	router.GET("tweets/:tweet_id/likes", likeController.GetTweetLikers)
+	router.POST("tweets/:tweet_id/retweet", retweetController.Retweet)
+	router.DELETE("tweets/:tweet_id/retweet", retweetController.Unretweet)
+	router.GET("tweets/:tweet_id/retweets", retweetController.GetRetweeters)
+	router.POST("tweets/:tweet_id/save", saveController.SaveTweet)
+	router.DELETE("tweets/:tweet_id/save", saveController.UnsaveTweet)
+
+	router.POST("comments/:comment_id/like", likeController.LikeComment)
+	router.DELETE("comments/:comment_id/like", likeController.UnlikeComment)
+	router.GET("comments/:comment_id/likes", likeController.GetCommentLikers)
+
+	router.GET("hashtags/:name/tweets", hashtagController.GetHashtagTweets)
+	router.GET("trends", trendController.GetTrends)
+
+	router.GET("timeline/home", timelineController.GetHomeTimeline)
 
 	router.POST("tokens/refresh", tokenController.RefreshAccessToken)
 	router.DELETE("tokens/remove", tokenController.DeleteRefreshToken)
@@ -0,0 +1,19 @@
+package save
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type Usecase interface {
+	Save(userID, tweetID string) error
+	Unsave(userID, tweetID string) error
+	GetSavedTweets(userID string, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error)
+}
+
+type Repository interface {
+	Create(save *models.Save) (bool, error)
+	Delete(save *models.Save) error
+	IsExist(userID, tweetID string) (bool, error)
+	GetByUserID(userID string, cursor *utils.Cursor, limit int) ([]*models.Save, error)
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/save"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type saveRepository struct {
+	DB *gorm.DB
+}
+
+func NewSaveRepository(db *gorm.DB) save.Repository {
+	return &saveRepository{DB: db}
+}
+
+// Create inserts the save unless it already exists. The returned bool is false when it already existed.
+func (repo *saveRepository) Create(save *models.Save) (bool, error) {
+	save.CreatedAt = time.Now()
+
+	result := repo.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(save)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (repo *saveRepository) Delete(save *models.Save) error {
+	return repo.DB.Where("user_id = ? AND tweet_id = ?", save.UserID, save.TweetID).Delete(&models.Save{}).Error
+}
+
+func (repo *saveRepository) IsExist(userID, tweetID string) (bool, error) {
+	var count int64
+	err := repo.DB.Table("saves").Where("user_id = ? AND tweet_id = ?", userID, tweetID).Count(&count).Error
+	return count > 0, err
+}
+
+func (repo *saveRepository) GetByUserID(userID string, cursor *utils.Cursor, limit int) ([]*models.Save, error) {
+	saves := make([]*models.Save, 0)
+
+	query := repo.DB.Table("saves").Where("user_id = ?", userID)
+	if cursor != nil {
+		query = query.Where("(created_at, tweet_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, tweet_id DESC").Limit(limit).Find(&saves).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return saves, nil
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/save"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type saveUsecase struct {
+	saveRepo     save.Repository
+	tweetRepo    tweet.Repository
+	tweetUsecase tweet.Usecase
+}
+
+func NewSaveUsecase(saveRepo save.Repository, tweetRepo tweet.Repository, tweetUsecase tweet.Usecase) save.Usecase {
+	return &saveUsecase{saveRepo: saveRepo, tweetRepo: tweetRepo, tweetUsecase: tweetUsecase}
+}
+
+// Save adds the tweet to the user's saved tweets. Users cannot save the tweets of a user who
+// has blocked them or whom they have blocked.
+func (usecase *saveUsecase) Save(userID, tweetID string) error {
+	err := usecase.tweetUsecase.VerifyViewPermission(tweetID, userID)
+	if err != nil {
+		return err
+	}
+
+	isCreated, err := usecase.saveRepo.Create(&models.Save{UserID: userID, TweetID: tweetID})
+	if err != nil {
+		return err
+	}
+
+	if !isCreated {
+		return custom_errors.ErrAlreadySaved
+	}
+
+	return nil
+}
+
+func (usecase *saveUsecase) Unsave(userID, tweetID string) error {
+	_, err := usecase.tweetRepo.GetByID(tweetID)
+	if err != nil {
+		return err
+	}
+
+	isSaved, err := usecase.saveRepo.IsExist(userID, tweetID)
+	if err != nil {
+		return err
+	}
+
+	if !isSaved {
+		return custom_errors.ErrNotSaved
+	}
+
+	return usecase.saveRepo.Delete(&models.Save{UserID: userID, TweetID: tweetID})
+}
+
+func (usecase *saveUsecase) GetSavedTweets(userID string, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error) {
+	saves, err := usecase.saveRepo.GetByUserID(userID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(saves) > limit {
+		saves = saves[:limit]
+		lastSave := saves[limit-1]
+		nextCursor = utils.NewCursor(lastSave.CreatedAt, lastSave.TweetID)
+	}
+
+	tweetIDs := make([]string, 0, len(saves))
+	for _, _save := range saves {
+		tweetIDs = append(tweetIDs, _save.TweetID)
+	}
+
+	tweets, err := usecase.tweetRepo.GetByIDs(tweetIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tweets, err = usecase.tweetUsecase.FilterViewableTweets(userID, tweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = usecase.tweetUsecase.AssignUsers(tweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tweetsByID := make(map[string]*models.Tweet, len(tweets))
+	for _, _tweet := range tweets {
+		tweetsByID[_tweet.ID] = _tweet
+	}
+
+	savedTweets := make([]*models.Tweet, 0, len(saves))
+	for _, _save := range saves {
+		_tweet, isExist := tweetsByID[_save.TweetID]
+		if !isExist {
+			continue
+		}
+
+		savedTweets = append(savedTweets, _tweet)
+	}
+
+	return savedTweets, nextCursor, nil
+}
@@ -0,0 +1,145 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/save"
+	saveMocks "github.com/jordyf15/tweeter-api/save/mocks"
+	"github.com/jordyf15/tweeter-api/save/usecase"
+	tweetMocks "github.com/jordyf15/tweeter-api/tweet/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSaveUsecase(t *testing.T) {
+	suite.Run(t, new(saveUsecaseSuite))
+}
+
+type saveUsecaseSuite struct {
+	suite.Suite
+	usecase      save.Usecase
+	saveRepo     *saveMocks.Repository
+	tweetRepo    *tweetMocks.Repository
+	tweetUsecase *tweetMocks.Usecase
+}
+
+var (
+	sutUser = &models.User{ID: "userID2", Username: "username2"}
+
+	sutSaves = []*models.Save{
+		{UserID: "userID1", TweetID: "tweetID2", CreatedAt: time.Now()},
+		{UserID: "userID1", TweetID: "tweetID1", CreatedAt: time.Now().Add(-time.Minute)},
+		{UserID: "userID1", TweetID: "tweetID3", CreatedAt: time.Now().Add(-time.Hour)},
+	}
+)
+
+func (s *saveUsecaseSuite) SetupTest() {
+	s.saveRepo = new(saveMocks.Repository)
+	s.tweetRepo = new(tweetMocks.Repository)
+	s.tweetUsecase = new(tweetMocks.Usecase)
+
+	isExist := func(userID, tweetID string) bool {
+		return tweetID == "tweetID2"
+	}
+
+	getByUserID := func(userID string, cursor *utils.Cursor, limit int) []*models.Save {
+		if limit > len(sutSaves) {
+			return sutSaves
+		}
+
+		return sutSaves[:limit]
+	}
+
+	getTweetsByIDs := func(ids []string) []*models.Tweet {
+		tweets := make([]*models.Tweet, 0, len(ids))
+		for _, id := range ids {
+			tweets = append(tweets, &models.Tweet{ID: id, UserID: "userID2", Description: "description"})
+		}
+
+		return tweets
+	}
+
+	s.saveRepo.On("Create", mock.AnythingOfType("*models.Save")).Return(func(save *models.Save) bool { return save.TweetID != "tweetID2" }, nil)
+	s.saveRepo.On("Delete", mock.AnythingOfType("*models.Save")).Return(nil)
+	s.saveRepo.On("IsExist", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isExist, nil)
+	s.saveRepo.On("GetByUserID", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("int")).Return(getByUserID, nil)
+	s.tweetRepo.On("GetByID", mock.AnythingOfType("string")).Return(&models.Tweet{ID: "tweetID1"}, nil)
+	s.tweetRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getTweetsByIDs, nil)
+	s.tweetUsecase.On("VerifyViewPermission", "tweetID3", mock.AnythingOfType("string")).Return(custom_errors.ErrUserBlocked)
+	s.tweetUsecase.On("VerifyViewPermission", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	s.tweetUsecase.On("FilterViewableTweets", mock.AnythingOfType("string"), mock.AnythingOfType("[]*models.Tweet")).Return(func(viewerID string, tweets []*models.Tweet) []*models.Tweet {
+		return tweets
+	}, nil)
+	s.tweetUsecase.On("AssignUsers", mock.AnythingOfType("[]*models.Tweet")).Return(nil).Run(func(args mock.Arguments) {
+		for _, _tweet := range args.Get(0).([]*models.Tweet) {
+			_tweet.User = sutUser
+		}
+	})
+
+	s.usecase = usecase.NewSaveUsecase(s.saveRepo, s.tweetRepo, s.tweetUsecase)
+}
+
+func (s *saveUsecaseSuite) TestSaveAlreadySaved() {
+	err := s.usecase.Save("userID1", "tweetID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrAlreadySaved.Error(), err.Error())
+}
+
+func (s *saveUsecaseSuite) TestSaveBlocked() {
+	err := s.usecase.Save("userID1", "tweetID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.saveRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *saveUsecaseSuite) TestSaveSuccessful() {
+	err := s.usecase.Save("userID1", "tweetID1")
+
+	assert.NoError(s.T(), err)
+	s.saveRepo.AssertCalled(s.T(), "Create", &models.Save{UserID: "userID1", TweetID: "tweetID1"})
+}
+
+func (s *saveUsecaseSuite) TestUnsaveNotSaved() {
+	err := s.usecase.Unsave("userID1", "tweetID1")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrNotSaved.Error(), err.Error())
+	s.saveRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *saveUsecaseSuite) TestUnsaveSuccessful() {
+	err := s.usecase.Unsave("userID1", "tweetID2")
+
+	assert.NoError(s.T(), err)
+	s.saveRepo.AssertNumberOfCalls(s.T(), "Delete", 1)
+}
+
+func (s *saveUsecaseSuite) TestGetSavedTweetsOrderedBySaveTime() {
+	tweets, nextCursor, err := s.usecase.GetSavedTweets("userID1", nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 2)
+	assert.Equal(s.T(), "tweetID2", tweets[0].ID)
+	assert.Equal(s.T(), "tweetID1", tweets[1].ID)
+	assert.Equal(s.T(), sutUser, tweets[0].User)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), "tweetID1", nextCursor.ID)
+	assert.Equal(s.T(), sutSaves[1].CreatedAt, nextCursor.CreatedAt)
+}
+
+func (s *saveUsecaseSuite) TestGetSavedTweetsLastPage() {
+	tweets, nextCursor, err := s.usecase.GetSavedTweets("userID1", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 3)
+	assert.Nil(s.T(), nextCursor)
+	s.tweetUsecase.AssertNumberOfCalls(s.T(), "AssignUsers", 1)
+	s.tweetUsecase.AssertCalled(s.T(), "FilterViewableTweets", "userID1", mock.AnythingOfType("[]*models.Tweet"))
+}
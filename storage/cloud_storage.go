@@ -88,6 +88,18 @@ func (storage *cloudStorage) AssignImageURLToGroup(group *models.Group) {
 	}
 }
 
+func (storage *cloudStorage) AssignImageURLToTweet(tweet *models.Tweet) {
+	for _, img := range tweet.Images {
+		img.URL, _ = storage.GetFileLink(tweet.ImagePath(img))
+	}
+}
+
+func (storage *cloudStorage) AssignImageURLToComment(comment *models.Comment) {
+	for _, img := range comment.Images {
+		img.URL, _ = storage.GetFileLink(comment.ImagePath(img))
+	}
+}
+
 func (api *cloudStorage) RemoveFile(respond chan<- error, wg *sync.WaitGroup, key string) {
 	if wg != nil {
 		defer wg.Done()
@@ -16,11 +16,21 @@ type Storage struct {
 	mock.Mock
 }
 
+// AssignImageURLToComment provides a mock function with given fields: model
+func (_m *Storage) AssignImageURLToComment(model *models.Comment) {
+	_m.Called(model)
+}
+
 // AssignImageURLToGroup provides a mock function with given fields: model
 func (_m *Storage) AssignImageURLToGroup(model *models.Group) {
 	_m.Called(model)
 }
 
+// AssignImageURLToTweet provides a mock function with given fields: model
+func (_m *Storage) AssignImageURLToTweet(model *models.Tweet) {
+	_m.Called(model)
+}
+
 // AssignImageURLToUser provides a mock function with given fields: model
 func (_m *Storage) AssignImageURLToUser(model *models.User) {
 	_m.Called(model)
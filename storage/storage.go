@@ -1,10 +1,13 @@
 package storage
 
 import (
+	"fmt"
 	"io"
+	"os"
 	"sync"
 
 	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
 )
 
 type Storage interface {
@@ -13,4 +16,49 @@ type Storage interface {
 	GetFileLink(key string) (string, error)
 	AssignImageURLToUser(model *models.User)
 	AssignImageURLToGroup(model *models.Group)
+	AssignImageURLToTweet(model *models.Tweet)
+	AssignImageURLToComment(model *models.Comment)
+}
+
+// UploadImages resizes the images to their width and height and uploads them to the keys returned by imagePath.
+// Every image is resized before any upload starts and all uploads are waited on before the resized files are removed.
+func UploadImages(storage Storage, imageReaders []utils.NamedFileReader, images []*models.Image, imagePath func(image *models.Image) string) error {
+	resizedImageFiles := make([]*os.File, 0, len(imageReaders))
+	defer func() {
+		for _, resizedImageFile := range resizedImageFiles {
+			resizedImageFile.Close()
+			os.Remove(resizedImageFile.Name())
+		}
+	}()
+
+	for i, imageReader := range imageReaders {
+		resizedImageFile, err := utils.ResizeImage(imageReader, int(images[i].Width), int(images[i].Height))
+		if resizedImageFile != nil {
+			resizedImageFiles = append(resizedImageFiles, resizedImageFile)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	uploadChannels := make(chan error, len(resizedImageFiles))
+	var wg sync.WaitGroup
+	wg.Add(len(resizedImageFiles))
+
+	for i, resizedImageFile := range resizedImageFiles {
+		go storage.UploadFile(uploadChannels, &wg, resizedImageFile, imagePath(images[i]), nil)
+	}
+
+	wg.Wait()
+	close(uploadChannels)
+
+	for err := range uploadChannels {
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	return nil
 }
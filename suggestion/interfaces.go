@@ -0,0 +1,28 @@
+package suggestion
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/models"
+)
+
+var (
+	// SuggestionCount is the number of accounts suggested to a user.
+	SuggestionCount = 20
+	// MutualFollowWeight is the score a candidate gets for each account followed by the user that follows them.
+	MutualFollowWeight = 2
+	// SharedGroupWeight is the score a candidate gets for each group they share with the user.
+	SharedGroupWeight = 1
+	// CacheDuration is how long computed suggestions are reused before being computed again.
+	CacheDuration = 30 * time.Minute
+)
+
+type Usecase interface {
+	GetSuggestions(userID string) ([]*models.UserProfile, error)
+}
+
+type Repository interface {
+	GetSuggestedUserIDs(userID string, limit int) ([]string, error)
+	GetCachedUserIDs(userID string) ([]string, bool, error)
+	SetCachedUserIDs(userID string, userIDs []string) error
+}
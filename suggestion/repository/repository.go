@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/suggestion"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const contextTimeout = time.Second * 30
+
+const (
+	RedisKeyUserSuggestionsPrefix = "user-suggestions"
+)
+
+type suggestionRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewSuggestionRepository(db *gorm.DB, redis *redis.Client) suggestion.Repository {
+	return &suggestionRepository{db: db, redis: redis}
+}
+
+// GetSuggestedUserIDs ranks the accounts followed by the accounts the user follows and the members
+// of the user's groups. Each mutual follow and each shared group adds to a candidate's score.
+// The user, the accounts they already follow and the accounts with a block in either direction are left out.
+func (repo *suggestionRepository) GetSuggestedUserIDs(userID string, limit int) ([]string, error) {
+	userIDs := make([]string, 0)
+
+	secondDegreeFollows := repo.db.Table("follows").
+		Select("second_follows.following_id AS user_id, ?::int AS score", suggestion.MutualFollowWeight).
+		Joins("JOIN follows AS second_follows ON second_follows.follower_id = follows.following_id").
+		Where("follows.follower_id = ?", userID)
+	groupMates := repo.db.Table("group_members").
+		Select("other_members.member_id AS user_id, ?::int AS score", suggestion.SharedGroupWeight).
+		Joins("JOIN group_members AS other_members ON other_members.group_id = group_members.group_id").
+		Where("group_members.member_id = ?", userID)
+	followings := repo.db.Table("follows").Select("following_id").Where("follower_id = ?", userID)
+	blockedUsers := repo.db.Table("blocks").Select("blocked_id").Where("blocker_id = ?", userID)
+	blockingUsers := repo.db.Table("blocks").Select("blocker_id").Where("blocked_id = ?", userID)
+
+	err := repo.db.Table("((?) UNION ALL (?)) AS candidates", secondDegreeFollows, groupMates).
+		Where("candidates.user_id <> ? AND candidates.user_id NOT IN (?)", userID, followings).
+		Where("candidates.user_id NOT IN (?) AND candidates.user_id NOT IN (?)", blockedUsers, blockingUsers).
+		Group("candidates.user_id").
+		Order("SUM(candidates.score) DESC, candidates.user_id").
+		Limit(limit).
+		Pluck("candidates.user_id", &userIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// GetCachedUserIDs returns the cached suggestions of the user.
+// The returned bool is false when the user has no cached suggestions.
+func (repo *suggestionRepository) GetCachedUserIDs(userID string) ([]string, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	value, err := repo.redis.Get(ctx, suggestionsKey(userID)).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	userIDs := make([]string, 0)
+	err = json.Unmarshal(value, &userIDs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return userIDs, true, nil
+}
+
+func (repo *suggestionRepository) SetCachedUserIDs(userID string, userIDs []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	value, err := json.Marshal(userIDs)
+	if err != nil {
+		return err
+	}
+
+	return repo.redis.Set(ctx, suggestionsKey(userID), value, suggestion.CacheDuration).Err()
+}
+
+func suggestionsKey(userID string) string {
+	return fmt.Sprintf("%s:%s", RedisKeyUserSuggestionsPrefix, userID)
+}
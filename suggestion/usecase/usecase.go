@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"github.com/jordyf15/tweeter-api/block"
+	"github.com/jordyf15/tweeter-api/follow"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/suggestion"
+	"github.com/jordyf15/tweeter-api/user"
+)
+
+type suggestionUsecase struct {
+	suggestionRepo suggestion.Repository
+	followRepo     follow.Repository
+	userRepo       user.Repository
+	blockRepo      block.Repository
+	storage        storage.Storage
+}
+
+func NewSuggestionUsecase(suggestionRepo suggestion.Repository, followRepo follow.Repository, userRepo user.Repository, blockRepo block.Repository, storage storage.Storage) suggestion.Usecase {
+	return &suggestionUsecase{suggestionRepo: suggestionRepo, followRepo: followRepo, userRepo: userRepo, blockRepo: blockRepo, storage: storage}
+}
+
+// GetSuggestions returns the accounts suggested for the user to follow, best match first.
+// Suggestions are cached for suggestion.CacheDuration, accounts the user followed or that
+// have a block with the user in the meantime are left out when reading them.
+func (usecase *suggestionUsecase) GetSuggestions(userID string) ([]*models.UserProfile, error) {
+	userIDs, isCached, err := usecase.suggestionRepo.GetCachedUserIDs(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isCached {
+		userIDs, err = usecase.suggestionRepo.GetSuggestedUserIDs(userID, suggestion.SuggestionCount)
+		if err != nil {
+			return nil, err
+		}
+
+		err = usecase.suggestionRepo.SetCachedUserIDs(userID, userIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	followingIDs, err := usecase.followRepo.GetFollowingIDsAmong(userID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	followerIDs, err := usecase.followRepo.GetFollowerIDsAmong(userID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedUserIDs, err := usecase.blockRepo.GetBlockedUserIDs(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	isFollowing := make(map[string]bool, len(followingIDs))
+	for _, followingID := range followingIDs {
+		isFollowing[followingID] = true
+	}
+
+	followsUser := make(map[string]bool, len(followerIDs))
+	for _, followerID := range followerIDs {
+		followsUser[followerID] = true
+	}
+
+	isBlocked := make(map[string]bool, len(blockedUserIDs))
+	for _, blockedUserID := range blockedUserIDs {
+		isBlocked[blockedUserID] = true
+	}
+
+	profiles := make([]*models.UserProfile, 0, len(userIDs))
+	for _, suggestedID := range userIDs {
+		_user, isExist := usersByID[suggestedID]
+		if !isExist || isFollowing[suggestedID] || isBlocked[suggestedID] {
+			continue
+		}
+
+		profiles = append(profiles, &models.UserProfile{User: _user, FollowsYou: followsUser[suggestedID]})
+	}
+
+	return profiles, nil
+}
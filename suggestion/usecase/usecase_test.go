@@ -0,0 +1,97 @@
+package usecase_test
+
+import (
+	"testing"
+
+	blockMocks "github.com/jordyf15/tweeter-api/block/mocks"
+	followMocks "github.com/jordyf15/tweeter-api/follow/mocks"
+	"github.com/jordyf15/tweeter-api/models"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	"github.com/jordyf15/tweeter-api/suggestion"
+	suggestionMocks "github.com/jordyf15/tweeter-api/suggestion/mocks"
+	"github.com/jordyf15/tweeter-api/suggestion/usecase"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuggestionUsecase(t *testing.T) {
+	suite.Run(t, new(suggestionUsecaseSuite))
+}
+
+type suggestionUsecaseSuite struct {
+	suite.Suite
+	usecase        suggestion.Usecase
+	suggestionRepo *suggestionMocks.Repository
+	followRepo     *followMocks.Repository
+	userRepo       *userMocks.Repository
+	blockRepo      *blockMocks.Repository
+	storageMock    *storageMocks.Storage
+	isCached       bool
+}
+
+func (s *suggestionUsecaseSuite) SetupTest() {
+	s.suggestionRepo = new(suggestionMocks.Repository)
+	s.followRepo = new(followMocks.Repository)
+	s.userRepo = new(userMocks.Repository)
+	s.blockRepo = new(blockMocks.Repository)
+	s.storageMock = new(storageMocks.Storage)
+	s.isCached = false
+
+	isCached := func(userID string) bool {
+		return s.isCached
+	}
+
+	getUsersByIDs := func(ids []string) []*models.User {
+		users := make([]*models.User, 0)
+		for _, id := range ids {
+			// userID5 has deleted their account
+			if id != "userID5" {
+				users = append(users, &models.User{ID: id, Email: id + "@mail.com"})
+			}
+		}
+
+		return users
+	}
+
+	s.suggestionRepo.On("GetCachedUserIDs", mock.AnythingOfType("string")).Return([]string{"userID3", "userID4", "userID5", "userID6"}, isCached, nil)
+	s.suggestionRepo.On("GetSuggestedUserIDs", mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return([]string{"userID2", "userID3"}, nil)
+	s.suggestionRepo.On("SetCachedUserIDs", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return(nil)
+	s.followRepo.On("GetFollowingIDsAmong", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return([]string{"userID4"}, nil)
+	s.followRepo.On("GetFollowerIDsAmong", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return([]string{"userID3"}, nil)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getUsersByIDs, nil)
+	s.blockRepo.On("GetBlockedUserIDs", mock.AnythingOfType("string")).Return([]string{"userID6"}, nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
+
+	s.usecase = usecase.NewSuggestionUsecase(s.suggestionRepo, s.followRepo, s.userRepo, s.blockRepo, s.storageMock)
+}
+
+func (s *suggestionUsecaseSuite) TestGetSuggestionsNotCached() {
+	users, err := s.usecase.GetSuggestions("userID1")
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), users, 2)
+	assert.Equal(s.T(), "userID2", users[0].ID)
+	assert.False(s.T(), users[0].FollowsYou)
+	assert.Empty(s.T(), users[0].Email)
+	assert.Equal(s.T(), "userID3", users[1].ID)
+	assert.True(s.T(), users[1].FollowsYou)
+	s.suggestionRepo.AssertCalled(s.T(), "GetSuggestedUserIDs", "userID1", suggestion.SuggestionCount)
+	s.suggestionRepo.AssertCalled(s.T(), "SetCachedUserIDs", "userID1", []string{"userID2", "userID3"})
+}
+
+func (s *suggestionUsecaseSuite) TestGetSuggestionsCached() {
+	s.isCached = true
+
+	users, err := s.usecase.GetSuggestions("userID1")
+
+	assert.NoError(s.T(), err)
+	s.suggestionRepo.AssertNumberOfCalls(s.T(), "GetSuggestedUserIDs", 0)
+	s.suggestionRepo.AssertNumberOfCalls(s.T(), "SetCachedUserIDs", 0)
+
+	// userID4 has been followed and userID6 has blocked the user since the suggestions were cached,
+	// userID5 no longer exists
+	assert.Len(s.T(), users, 1)
+	assert.Equal(s.T(), "userID3", users[0].ID)
+}
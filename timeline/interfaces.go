@@ -0,0 +1,28 @@
+package timeline
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+var (
+	// CachedEntryCount is the maximum number of entries kept in a user's cached home timeline.
+	CachedEntryCount = 800
+	// FanOutFollowerLimit is the follower count from which an author's entries are no longer
+	// pushed to their followers' cached timelines but merged in when the timeline is read.
+	FanOutFollowerLimit = uint(10000)
+)
+
+type Usecase interface {
+	GetHomeTimeline(userID string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, *utils.Cursor, error)
+	PushEntry(author *models.User, entry *models.TimelineEntry) error
+	RemoveEntry(author *models.User, entry *models.TimelineEntry) error
+}
+
+type Repository interface {
+	GetHomeTimeline(userID string) ([]*models.TimelineEntry, bool, error)
+	SetHomeTimeline(userID string, entries []*models.TimelineEntry) error
+	PushEntry(userIDs []string, entry *models.TimelineEntry) error
+	RemoveEntry(userIDs []string, entry *models.TimelineEntry) error
+	DeleteHomeTimeline(userID string) error
+}
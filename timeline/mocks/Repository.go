@@ -0,0 +1,114 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// DeleteHomeTimeline provides a mock function with given fields: userID
+func (_m *Repository) DeleteHomeTimeline(userID string) error {
+	ret := _m.Called(userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetHomeTimeline provides a mock function with given fields: userID
+func (_m *Repository) GetHomeTimeline(userID string) ([]*models.TimelineEntry, bool, error) {
+	ret := _m.Called(userID)
+
+	var r0 []*models.TimelineEntry
+	if rf, ok := ret.Get(0).(func(string) []*models.TimelineEntry); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TimelineEntry)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PushEntry provides a mock function with given fields: userIDs, entry
+func (_m *Repository) PushEntry(userIDs []string, entry *models.TimelineEntry) error {
+	ret := _m.Called(userIDs, entry)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, *models.TimelineEntry) error); ok {
+		r0 = rf(userIDs, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveEntry provides a mock function with given fields: userIDs, entry
+func (_m *Repository) RemoveEntry(userIDs []string, entry *models.TimelineEntry) error {
+	ret := _m.Called(userIDs, entry)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, *models.TimelineEntry) error); ok {
+		r0 = rf(userIDs, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetHomeTimeline provides a mock function with given fields: userID, entries
+func (_m *Repository) SetHomeTimeline(userID string, entries []*models.TimelineEntry) error {
+	ret := _m.Called(userID, entries)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []*models.TimelineEntry) error); ok {
+		r0 = rf(userID, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
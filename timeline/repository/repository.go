@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/timeline"
+	"github.com/redis/go-redis/v9"
+)
+
+const contextTimeout = time.Second * 30
+
+const (
+	RedisKeyHomeTimelinePrefix = "home-timeline"
+	homeTimelineTTL            = 24 * time.Hour
+	// emptyHomeTimelineMarker is cached in place of an empty home timeline so that it is not rebuilt on every read.
+	emptyHomeTimelineMarker = "empty"
+)
+
+type cachedEntry struct {
+	ID          string    `json:"id"`
+	TweetID     string    `json:"tweet_id"`
+	UserID      string    `json:"user_id"`
+	RetweeterID *string   `json:"retweeter_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type timelineRepository struct {
+	redis *redis.Client
+}
+
+func NewTimelineRepository(redis *redis.Client) timeline.Repository {
+	return &timelineRepository{redis: redis}
+}
+
+// GetHomeTimeline returns the cached home timeline of the user, newest first.
+// The returned bool is false when the user has no cached timeline.
+func (repo *timelineRepository) GetHomeTimeline(userID string) ([]*models.TimelineEntry, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	values, err := repo.redis.LRange(ctx, homeTimelineKey(userID), 0, -1).Result()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+
+	entries := make([]*models.TimelineEntry, 0, len(values))
+	for _, value := range values {
+		if value == emptyHomeTimelineMarker {
+			continue
+		}
+
+		cached := &cachedEntry{}
+		err := json.Unmarshal([]byte(value), cached)
+		if err != nil {
+			return nil, false, err
+		}
+
+		entries = append(entries, &models.TimelineEntry{
+			ID:          cached.ID,
+			TweetID:     cached.TweetID,
+			UserID:      cached.UserID,
+			RetweeterID: cached.RetweeterID,
+			CreatedAt:   cached.CreatedAt,
+		})
+	}
+
+	return entries, true, nil
+}
+
+// SetHomeTimeline replaces the cached home timeline of the user. An empty timeline is cached
+// as well, so that users who follow nobody do not have it rebuilt from the database on every read.
+func (repo *timelineRepository) SetHomeTimeline(userID string, entries []*models.TimelineEntry) error {
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	key := homeTimelineKey(userID)
+	values := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		value, err := encodeEntry(entry)
+		if err != nil {
+			return err
+		}
+
+		values = append(values, value)
+	}
+
+	if len(values) == 0 {
+		values = append(values, emptyHomeTimelineMarker)
+	}
+
+	pipe := repo.redis.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.RPush(ctx, key, values...)
+	pipe.LTrim(ctx, key, 0, int64(timeline.CachedEntryCount-1))
+	pipe.Expire(ctx, key, homeTimelineTTL)
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// PushEntry prepends the entry to the cached home timelines of the users. Users without
+// a cached timeline are skipped, their timeline is built from the database on the next read.
+func (repo *timelineRepository) PushEntry(userIDs []string, entry *models.TimelineEntry) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	value, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	pipe := repo.redis.Pipeline()
+	for _, userID := range userIDs {
+		key := homeTimelineKey(userID)
+		pipe.LPushX(ctx, key, value)
+		pipe.LTrim(ctx, key, 0, int64(timeline.CachedEntryCount-1))
+	}
+
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// RemoveEntry removes the entry from the cached home timelines of the users. Entries are encoded the same way
+// wherever they were read from, so the entry is removed by its encoded value without reading the timelines.
+func (repo *timelineRepository) RemoveEntry(userIDs []string, entry *models.TimelineEntry) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	value, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	pipe := repo.redis.Pipeline()
+	for _, userID := range userIDs {
+		pipe.LRem(ctx, homeTimelineKey(userID), 0, value)
+	}
+
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (repo *timelineRepository) DeleteHomeTimeline(userID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	return repo.redis.Del(ctx, homeTimelineKey(userID)).Err()
+}
+
+func homeTimelineKey(userID string) string {
+	return fmt.Sprintf("%s:%s", RedisKeyHomeTimelinePrefix, userID)
+}
+
+// encodeEntry encodes the entry with its creation time in UTC at the microsecond precision of the database,
+// so that an entry pushed right after it was created is encoded the same as when it is read back.
+func encodeEntry(entry *models.TimelineEntry) (string, error) {
+	value, err := json.Marshal(&cachedEntry{
+		ID:          entry.ID,
+		TweetID:     entry.TweetID,
+		UserID:      entry.UserID,
+		RetweeterID: entry.RetweeterID,
+		CreatedAt:   entry.CreatedAt.UTC().Truncate(time.Microsecond),
+	})
+
+	return string(value), err
+}
@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"github.com/jordyf15/tweeter-api/block"
+	"github.com/jordyf15/tweeter-api/follow"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/mute"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/timeline"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/user"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+type timelineUsecase struct {
+	timelineRepo timeline.Repository
+	tweetRepo    tweet.Repository
+	followRepo   follow.Repository
+	userRepo     user.Repository
+	blockRepo    block.Repository
+	muteRepo     mute.Repository
+	storage      storage.Storage
+}
+
+func NewTimelineUsecase(timelineRepo timeline.Repository, tweetRepo tweet.Repository, followRepo follow.Repository, userRepo user.Repository, blockRepo block.Repository, muteRepo mute.Repository, storage storage.Storage) timeline.Usecase {
+	return &timelineUsecase{timelineRepo: timelineRepo, tweetRepo: tweetRepo, followRepo: followRepo, userRepo: userRepo, blockRepo: blockRepo, muteRepo: muteRepo, storage: storage}
+}
+
+func (usecase *timelineUsecase) GetHomeTimeline(userID string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, *utils.Cursor, error) {
+	entries, err := usecase.getHomeTimelineEntries(userID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(entries) > limit {
+		entries = entries[:limit]
+		lastEntry := entries[limit-1]
+		nextCursor = utils.NewCursor(lastEntry.CreatedAt, lastEntry.ID)
+	}
+
+	entries, err = usecase.hydrateEntries(userID, entries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, nextCursor, nil
+}
+
+// PushEntry adds the entry to the cached home timelines of the author and their followers.
+// Followers of authors with at least FanOutFollowerLimit followers get the entry when they read their timeline instead.
+func (usecase *timelineUsecase) PushEntry(author *models.User, entry *models.TimelineEntry) error {
+	userIDs, err := usecase.getFanOutUserIDs(author)
+	if err != nil {
+		return err
+	}
+
+	return usecase.timelineRepo.PushEntry(userIDs, entry)
+}
+
+// RemoveEntry removes the entry from the cached home timelines it was pushed to by PushEntry.
+func (usecase *timelineUsecase) RemoveEntry(author *models.User, entry *models.TimelineEntry) error {
+	userIDs, err := usecase.getFanOutUserIDs(author)
+	if err != nil {
+		return err
+	}
+
+	return usecase.timelineRepo.RemoveEntry(userIDs, entry)
+}
+
+// getFanOutUserIDs returns the IDs of the users whose cached home timelines hold the entries of the author.
+func (usecase *timelineUsecase) getFanOutUserIDs(author *models.User) ([]string, error) {
+	userIDs := []string{author.ID}
+
+	if author.FollowerCount < timeline.FanOutFollowerLimit {
+		followerIDs, err := usecase.followRepo.GetFollowerIDs(author.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		userIDs = append(userIDs, followerIDs...)
+	}
+
+	return userIDs, nil
+}
+
+// getHomeTimelineEntries merges the cached home timeline with the entries of followed authors
+// that are not fanned out. The cache is rebuilt from the database when it is missing, and the
+// database is read directly once the page goes past the oldest cached entry.
+func (usecase *timelineUsecase) getHomeTimelineEntries(userID string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, error) {
+	unfannedUserIDs, err := usecase.followRepo.GetFollowingIDsWithMinFollowerCount(userID, timeline.FanOutFollowerLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedEntries, isExist, err := usecase.timelineRepo.GetHomeTimeline(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		cachedEntries, err = usecase.tweetRepo.GetHomeTimeline(userID, unfannedUserIDs, nil, timeline.CachedEntryCount)
+		if err != nil {
+			return nil, err
+		}
+
+		err = usecase.timelineRepo.SetHomeTimeline(userID, cachedEntries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entries := make([]*models.TimelineEntry, 0, limit)
+	for _, entry := range cachedEntries {
+		if len(entries) == limit {
+			break
+		}
+
+		if isBeforeCursor(entry, cursor) {
+			entries = append(entries, entry)
+		}
+	}
+
+	if len(entries) < limit && len(cachedEntries) >= timeline.CachedEntryCount {
+		return usecase.tweetRepo.GetHomeTimeline(userID, nil, cursor, limit)
+	}
+
+	unfannedEntries, err := usecase.tweetRepo.GetUsersTimeline(unfannedUserIDs, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeEntries(entries, unfannedEntries, limit), nil
+}
+
+// hydrateEntries assigns the tweets, their authors and the retweeters to the entries.
+// Entries whose tweet no longer exists, whose author or retweeter has a block with the user
+// or is muted by the user, or whose tweet contains a keyword muted by the user are dropped.
+func (usecase *timelineUsecase) hydrateEntries(userID string, entries []*models.TimelineEntry) ([]*models.TimelineEntry, error) {
+	blockedUserIDs, err := usecase.blockRepo.GetBlockedUserIDs(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	muteFilter, err := mute.GetFilter(usecase.muteRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	isHidden := make(map[string]bool, len(blockedUserIDs))
+	for _, blockedUserID := range blockedUserIDs {
+		isHidden[blockedUserID] = true
+	}
+
+	tweetIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		tweetIDs = append(tweetIDs, entry.TweetID)
+	}
+
+	tweets, err := usecase.tweetRepo.GetByIDs(tweetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tweetsByID := make(map[string]*models.Tweet, len(tweets))
+	userIDs := make([]string, 0, len(tweets)+len(entries))
+	for _, _tweet := range tweets {
+		tweetsByID[_tweet.ID] = _tweet
+		userIDs = append(userIDs, _tweet.UserID)
+	}
+
+	for _, entry := range entries {
+		if entry.RetweeterID != nil {
+			userIDs = append(userIDs, *entry.RetweeterID)
+		}
+	}
+
+	// retweets can bring tweets of protected users the viewer does not follow
+	protectedUserIDs, err := usecase.followRepo.GetProtectedIDsNotFollowedAmong(userID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, protectedUserID := range protectedUserIDs {
+		isHidden[protectedUserID] = true
+	}
+
+	usersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, _tweet := range tweets {
+		_tweet.User = usersByID[_tweet.UserID]
+		usecase.storage.AssignImageURLToTweet(_tweet)
+	}
+
+	hydratedEntries := make([]*models.TimelineEntry, 0, len(entries))
+	for _, entry := range entries {
+		_tweet, isExist := tweetsByID[entry.TweetID]
+		if !isExist || isHidden[_tweet.UserID] || (entry.RetweeterID != nil && isHidden[*entry.RetweeterID]) {
+			continue
+		}
+
+		if muteFilter.IsTweetMuted(_tweet) || (entry.RetweeterID != nil && muteFilter.IsUserMuted(*entry.RetweeterID)) {
+			continue
+		}
+
+		entry.Tweet = _tweet
+		if entry.RetweeterID != nil {
+			entry.RetweetedBy = usersByID[*entry.RetweeterID]
+		}
+
+		hydratedEntries = append(hydratedEntries, entry)
+	}
+
+	return hydratedEntries, nil
+}
+
+func isBeforeCursor(entry *models.TimelineEntry, cursor *utils.Cursor) bool {
+	if cursor == nil {
+		return true
+	}
+
+	if entry.CreatedAt.Equal(cursor.CreatedAt) {
+		return entry.ID < cursor.ID
+	}
+
+	return entry.CreatedAt.Before(cursor.CreatedAt)
+}
+
+// mergeEntries merges two timelines ordered newest first, keeping at most limit entries.
+// Entries present in both are only kept once.
+func mergeEntries(a, b []*models.TimelineEntry, limit int) []*models.TimelineEntry {
+	merged := make([]*models.TimelineEntry, 0, limit)
+	isAdded := make(map[string]bool)
+
+	for len(merged) < limit && (len(a) > 0 || len(b) > 0) {
+		var next *models.TimelineEntry
+		if len(b) == 0 || (len(a) > 0 && !isBeforeCursor(a[0], utils.NewCursor(b[0].CreatedAt, b[0].ID))) {
+			next, a = a[0], a[1:]
+		} else {
+			next, b = b[0], b[1:]
+		}
+
+		if isAdded[next.ID] {
+			continue
+		}
+
+		isAdded[next.ID] = true
+		merged = append(merged, next)
+	}
+
+	return merged
+}
@@ -0,0 +1,315 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	blockMocks "github.com/jordyf15/tweeter-api/block/mocks"
+	followMocks "github.com/jordyf15/tweeter-api/follow/mocks"
+	"github.com/jordyf15/tweeter-api/models"
+	muteMocks "github.com/jordyf15/tweeter-api/mute/mocks"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	"github.com/jordyf15/tweeter-api/timeline"
+	timelineMocks "github.com/jordyf15/tweeter-api/timeline/mocks"
+	"github.com/jordyf15/tweeter-api/timeline/usecase"
+	tweetMocks "github.com/jordyf15/tweeter-api/tweet/mocks"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTimelineUsecase(t *testing.T) {
+	suite.Run(t, new(timelineUsecaseSuite))
+}
+
+type timelineUsecaseSuite struct {
+	suite.Suite
+	usecase         timeline.Usecase
+	timelineRepo    *timelineMocks.Repository
+	tweetRepo       *tweetMocks.Repository
+	followRepo      *followMocks.Repository
+	userRepo        *userMocks.Repository
+	blockRepo       *blockMocks.Repository
+	muteRepo        *muteMocks.Repository
+	storageMock     *storageMocks.Storage
+	isCached        bool
+	cachedEntries   []*models.TimelineEntry
+	unfannedUserIDs []string
+	unfannedEntries []*models.TimelineEntry
+	blockedUserIDs  []string
+	protectedIDs    []string
+	mutes           []*models.Mute
+}
+
+var (
+	tutNow         = time.Now()
+	tutRetweeterID = "userID2"
+
+	tutUsers = []*models.User{
+		{ID: "userID1", Username: "username1"},
+		{ID: "userID2", Username: "username2"},
+		{ID: "userID3", Username: "username3"},
+	}
+
+	tutTweets = []*models.Tweet{
+		{ID: "tweetID1", UserID: "userID1", Description: "tweet 1"},
+		{ID: "tweetID2", UserID: "userID1", Description: "tweet 2"},
+		{ID: "tweetID4", UserID: "userID3", Description: "tweet 4"},
+	}
+
+	tutEntries = []*models.TimelineEntry{
+		{ID: "tweetID1:userID2", TweetID: "tweetID1", UserID: "userID2", RetweeterID: &tutRetweeterID, CreatedAt: tutNow},
+		{ID: "tweetID3", TweetID: "tweetID3", UserID: "userID1", CreatedAt: tutNow.Add(-time.Minute)},
+		{ID: "tweetID2", TweetID: "tweetID2", UserID: "userID1", CreatedAt: tutNow.Add(-time.Hour)},
+		{ID: "tweetID1", TweetID: "tweetID1", UserID: "userID1", CreatedAt: tutNow.Add(-2 * time.Hour)},
+	}
+)
+
+func (s *timelineUsecaseSuite) SetupTest() {
+	s.timelineRepo = new(timelineMocks.Repository)
+	s.tweetRepo = new(tweetMocks.Repository)
+	s.followRepo = new(followMocks.Repository)
+	s.userRepo = new(userMocks.Repository)
+	s.blockRepo = new(blockMocks.Repository)
+	s.muteRepo = new(muteMocks.Repository)
+	s.storageMock = new(storageMocks.Storage)
+
+	s.isCached = false
+	s.cachedEntries = nil
+	s.unfannedUserIDs = []string{}
+	s.unfannedEntries = []*models.TimelineEntry{}
+	s.blockedUserIDs = []string{}
+	s.protectedIDs = []string{}
+	s.mutes = []*models.Mute{}
+
+	limitEntries := func(entries []*models.TimelineEntry, limit int) []*models.TimelineEntry {
+		if limit > len(entries) {
+			return entries
+		}
+
+		return entries[:limit]
+	}
+
+	getHomeTimeline := func(userID string, excludedUserIDs []string, cursor *utils.Cursor, limit int) []*models.TimelineEntry {
+		return limitEntries(tutEntries, limit)
+	}
+
+	getUsersTimeline := func(userIDs []string, cursor *utils.Cursor, limit int) []*models.TimelineEntry {
+		return limitEntries(s.unfannedEntries, limit)
+	}
+
+	getCachedHomeTimeline := func(userID string) []*models.TimelineEntry {
+		return s.cachedEntries
+	}
+
+	isCacheExist := func(userID string) bool {
+		return s.isCached
+	}
+
+	getFollowingIDsWithMinFollowerCount := func(followerID string, minFollowerCount uint) []string {
+		return s.unfannedUserIDs
+	}
+
+	getBlockedUserIDs := func(userID string) []string {
+		return s.blockedUserIDs
+	}
+
+	getProtectedIDsNotFollowedAmong := func(viewerID string, userIDs []string) []string {
+		return s.protectedIDs
+	}
+
+	getActiveMutes := func(userID string) []*models.Mute {
+		return s.mutes
+	}
+
+	getTweetsByIDs := func(ids []string) []*models.Tweet {
+		tweets := make([]*models.Tweet, 0)
+		for _, _tweet := range tutTweets {
+			for _, id := range ids {
+				if _tweet.ID == id {
+					tweets = append(tweets, &models.Tweet{ID: _tweet.ID, UserID: _tweet.UserID, Description: _tweet.Description})
+					break
+				}
+			}
+		}
+
+		return tweets
+	}
+
+	s.timelineRepo.On("GetHomeTimeline", mock.AnythingOfType("string")).Return(getCachedHomeTimeline, isCacheExist, nil)
+	s.timelineRepo.On("SetHomeTimeline", mock.AnythingOfType("string"), mock.AnythingOfType("[]*models.TimelineEntry")).Return(nil)
+	s.timelineRepo.On("PushEntry", mock.AnythingOfType("[]string"), mock.AnythingOfType("*models.TimelineEntry")).Return(nil)
+	s.timelineRepo.On("RemoveEntry", mock.AnythingOfType("[]string"), mock.AnythingOfType("*models.TimelineEntry")).Return(nil)
+	s.tweetRepo.On("GetHomeTimeline", mock.AnythingOfType("string"), mock.Anything, mock.Anything, mock.AnythingOfType("int")).Return(getHomeTimeline, nil)
+	s.tweetRepo.On("GetUsersTimeline", mock.AnythingOfType("[]string"), mock.Anything, mock.AnythingOfType("int")).Return(getUsersTimeline, nil)
+	s.tweetRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getTweetsByIDs, nil)
+	s.followRepo.On("GetFollowingIDsWithMinFollowerCount", mock.AnythingOfType("string"), mock.AnythingOfType("uint")).Return(getFollowingIDsWithMinFollowerCount, nil)
+	s.followRepo.On("GetFollowerIDs", mock.AnythingOfType("string")).Return([]string{"userID2", "userID3"}, nil)
+	s.followRepo.On("GetProtectedIDsNotFollowedAmong", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return(getProtectedIDsNotFollowedAmong, nil)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(tutUsers, nil)
+	s.blockRepo.On("GetBlockedUserIDs", mock.AnythingOfType("string")).Return(getBlockedUserIDs, nil)
+	s.muteRepo.On("GetActiveByUserID", mock.AnythingOfType("string")).Return(getActiveMutes, nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
+	s.storageMock.On("AssignImageURLToTweet", mock.AnythingOfType("*models.Tweet"))
+
+	s.usecase = usecase.NewTimelineUsecase(s.timelineRepo, s.tweetRepo, s.followRepo, s.userRepo, s.blockRepo, s.muteRepo, s.storageMock)
+}
+
+func (s *timelineUsecaseSuite) TestGetHomeTimelineRebuildsMissingCache() {
+	entries, nextCursor, err := s.usecase.GetHomeTimeline("userID1", nil, 3)
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), "tweetID2", nextCursor.ID)
+	s.tweetRepo.AssertCalled(s.T(), "GetHomeTimeline", "userID1", []string{}, mock.Anything, timeline.CachedEntryCount)
+	s.timelineRepo.AssertCalled(s.T(), "SetHomeTimeline", "userID1", tutEntries)
+
+	// tweetID3 has been deleted since it was read from the timeline
+	assert.Len(s.T(), entries, 2)
+	assert.Equal(s.T(), "tweetID1", entries[0].Tweet.ID)
+	assert.Equal(s.T(), tutUsers[0], entries[0].Tweet.User)
+	assert.Equal(s.T(), tutUsers[1], entries[0].RetweetedBy)
+	assert.Equal(s.T(), "tweetID2", entries[1].Tweet.ID)
+	assert.Nil(s.T(), entries[1].RetweetedBy)
+}
+
+func (s *timelineUsecaseSuite) TestGetHomeTimelineFromCache() {
+	s.isCached = true
+	s.cachedEntries = tutEntries
+
+	entries, nextCursor, err := s.usecase.GetHomeTimeline("userID1", utils.NewCursor(tutEntries[0].CreatedAt, tutEntries[0].ID), 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	assert.Len(s.T(), entries, 2)
+	assert.Equal(s.T(), "tweetID2", entries[0].ID)
+	assert.Equal(s.T(), "tweetID1", entries[1].ID)
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "GetHomeTimeline", 0)
+	s.timelineRepo.AssertNumberOfCalls(s.T(), "SetHomeTimeline", 0)
+}
+
+func (s *timelineUsecaseSuite) TestGetHomeTimelineMergesUnfannedAuthors() {
+	s.isCached = true
+	s.cachedEntries = tutEntries
+	s.unfannedUserIDs = []string{"userID3"}
+	s.unfannedEntries = []*models.TimelineEntry{
+		{ID: "tweetID4", TweetID: "tweetID4", UserID: "userID3", CreatedAt: tutNow.Add(-30 * time.Minute)},
+	}
+
+	entries, nextCursor, err := s.usecase.GetHomeTimeline("userID1", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), nextCursor)
+	assert.Len(s.T(), entries, 4)
+	assert.Equal(s.T(), "tweetID1:userID2", entries[0].ID)
+	assert.Equal(s.T(), "tweetID4", entries[1].ID)
+	assert.Equal(s.T(), tutUsers[2], entries[1].Tweet.User)
+	assert.Equal(s.T(), "tweetID2", entries[2].ID)
+	s.tweetRepo.AssertCalled(s.T(), "GetUsersTimeline", []string{"userID3"}, mock.Anything, 21)
+}
+
+func (s *timelineUsecaseSuite) TestGetHomeTimelineSkipsBlockedUsers() {
+	s.isCached = true
+	s.cachedEntries = tutEntries
+	s.blockedUserIDs = []string{"userID2"}
+
+	entries, _, err := s.usecase.GetHomeTimeline("userID1", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), entries, 2)
+	assert.Equal(s.T(), "tweetID2", entries[0].ID)
+	assert.Equal(s.T(), "tweetID1", entries[1].ID)
+	s.blockRepo.AssertCalled(s.T(), "GetBlockedUserIDs", "userID1")
+}
+
+func (s *timelineUsecaseSuite) TestGetHomeTimelineSkipsProtectedUsers() {
+	s.isCached = true
+	s.unfannedUserIDs = []string{"userID3"}
+	s.cachedEntries = []*models.TimelineEntry{
+		{ID: "tweetID4:userID2", TweetID: "tweetID4", UserID: "userID2", RetweeterID: &tutRetweeterID, CreatedAt: tutNow},
+		{ID: "tweetID2", TweetID: "tweetID2", UserID: "userID1", CreatedAt: tutNow.Add(-time.Hour)},
+	}
+	s.protectedIDs = []string{"userID3"}
+
+	entries, _, err := s.usecase.GetHomeTimeline("userID1", nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), entries, 1)
+	assert.Equal(s.T(), "tweetID2", entries[0].ID)
+	s.followRepo.AssertCalled(s.T(), "GetProtectedIDsNotFollowedAmong", "userID1", []string{"userID1", "userID3", "userID2"})
+}
+
+func (s *timelineUsecaseSuite) TestGetHomeTimelineSkipsMutedContent() {
+	mutedUserID := "userID2"
+	ownKeyword := "tweet 2"
+	mutedKeyword := "tweet 4"
+
+	s.isCached = true
+	s.cachedEntries = tutEntries
+	s.unfannedUserIDs = []string{"userID3"}
+	s.unfannedEntries = []*models.TimelineEntry{
+		{ID: "tweetID4", TweetID: "tweetID4", UserID: "userID3", CreatedAt: tutNow.Add(-30 * time.Minute)},
+	}
+	s.mutes = []*models.Mute{
+		{ID: "muteID1", UserID: "userID1", MutedUserID: &mutedUserID},
+		{ID: "muteID2", UserID: "userID1", Keyword: &ownKeyword},
+		{ID: "muteID3", UserID: "userID1", Keyword: &mutedKeyword},
+	}
+
+	entries, _, err := s.usecase.GetHomeTimeline("userID1", nil, 20)
+
+	assert.NoError(s.T(), err)
+	s.muteRepo.AssertCalled(s.T(), "GetActiveByUserID", "userID1")
+
+	// the retweet by the muted user and the tweet with the muted keyword are dropped,
+	// the user's own tweets are never muted
+	assert.Len(s.T(), entries, 2)
+	assert.Equal(s.T(), "tweetID2", entries[0].ID)
+	assert.Equal(s.T(), "tweetID1", entries[1].ID)
+}
+
+func (s *timelineUsecaseSuite) TestGetHomeTimelinePastCachedEntries() {
+	cachedEntryCount := timeline.CachedEntryCount
+	timeline.CachedEntryCount = 2
+	defer func() { timeline.CachedEntryCount = cachedEntryCount }()
+
+	s.isCached = true
+	s.cachedEntries = tutEntries[:2]
+	cursor := utils.NewCursor(tutEntries[1].CreatedAt, tutEntries[1].ID)
+
+	entries, _, err := s.usecase.GetHomeTimeline("userID1", cursor, 20)
+
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), entries)
+	s.tweetRepo.AssertCalled(s.T(), "GetHomeTimeline", "userID1", []string(nil), cursor, 21)
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "GetUsersTimeline", 0)
+}
+
+func (s *timelineUsecaseSuite) TestPushEntryToFollowers() {
+	entry := &models.TimelineEntry{ID: "tweetID1", TweetID: "tweetID1", UserID: "userID1", CreatedAt: tutNow}
+
+	err := s.usecase.PushEntry(&models.User{ID: "userID1", FollowerCount: 2}, entry)
+
+	assert.NoError(s.T(), err)
+	s.timelineRepo.AssertCalled(s.T(), "PushEntry", []string{"userID1", "userID2", "userID3"}, entry)
+}
+
+func (s *timelineUsecaseSuite) TestPushEntrySkipsFollowersOfLargeAccounts() {
+	entry := &models.TimelineEntry{ID: "tweetID1", TweetID: "tweetID1", UserID: "userID1", CreatedAt: tutNow}
+
+	err := s.usecase.PushEntry(&models.User{ID: "userID1", FollowerCount: timeline.FanOutFollowerLimit}, entry)
+
+	assert.NoError(s.T(), err)
+	s.followRepo.AssertNumberOfCalls(s.T(), "GetFollowerIDs", 0)
+	s.timelineRepo.AssertCalled(s.T(), "PushEntry", []string{"userID1"}, entry)
+}
+
+func (s *timelineUsecaseSuite) TestRemoveEntryFromFollowers() {
+	entry := &models.TimelineEntry{ID: "tweetID1:userID1", TweetID: "tweetID1", UserID: "userID1", CreatedAt: time.Now()}
+	err := s.usecase.RemoveEntry(&models.User{ID: "userID1", FollowerCount: 2}, entry)
+
+	assert.NoError(s.T(), err)
+	s.timelineRepo.AssertCalled(s.T(), "RemoveEntry", []string{"userID1", "userID2", "userID3"}, entry)
+}
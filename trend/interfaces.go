@@ -0,0 +1,20 @@
+package trend
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/models"
+)
+
+var (
+	TrendingHashtagCount = 10
+)
+
+type Usecase interface {
+	GetTrends(window models.TrendWindow) ([]*models.Trend, error)
+}
+
+type Repository interface {
+	IncrementHashtags(names []string, usedAt time.Time) error
+	GetTopHashtags(window models.TrendWindow, limit int, now time.Time) ([]*models.Trend, error)
+}
@@ -0,0 +1,67 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// GetTopHashtags provides a mock function with given fields: window, limit, now
+func (_m *Repository) GetTopHashtags(window models.TrendWindow, limit int, now time.Time) ([]*models.Trend, error) {
+	ret := _m.Called(window, limit, now)
+
+	var r0 []*models.Trend
+	if rf, ok := ret.Get(0).(func(models.TrendWindow, int, time.Time) []*models.Trend); ok {
+		r0 = rf(window, limit, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Trend)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(models.TrendWindow, int, time.Time) error); ok {
+		r1 = rf(window, limit, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementHashtags provides a mock function with given fields: names, usedAt
+func (_m *Repository) IncrementHashtags(names []string, usedAt time.Time) error {
+	ret := _m.Called(names, usedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, time.Time) error); ok {
+		r0 = rf(names, usedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
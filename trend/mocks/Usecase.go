@@ -0,0 +1,51 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Usecase is an autogenerated mock type for the Usecase type
+type Usecase struct {
+	mock.Mock
+}
+
+// GetTrends provides a mock function with given fields: window
+func (_m *Usecase) GetTrends(window models.TrendWindow) ([]*models.Trend, error) {
+	ret := _m.Called(window)
+
+	var r0 []*models.Trend
+	if rf, ok := ret.Get(0).(func(models.TrendWindow) []*models.Trend); ok {
+		r0 = rf(window)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Trend)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(models.TrendWindow) error); ok {
+		r1 = rf(window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewUsecase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUsecase creates a new instance of Usecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUsecase(t mockConstructorTestingTNewUsecase) *Usecase {
+	mock := &Usecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/trend"
+	"github.com/redis/go-redis/v9"
+)
+
+const contextTimeout = time.Second * 30
+
+const (
+	RedisKeyTrendingHashtagsPrefix = "trending-hashtags"
+)
+
+// windowConfig splits a trend window into buckets holding hashtag usage counts. When
+// reading, each bucket is weighted by how long ago it started so older usage counts less.
+type windowConfig struct {
+	bucketSize  time.Duration
+	bucketCount int
+	halfLife    time.Duration
+}
+
+var windowConfigs = map[models.TrendWindow]windowConfig{
+	models.TrendWindowHour: {bucketSize: 5 * time.Minute, bucketCount: 12, halfLife: 20 * time.Minute},
+	models.TrendWindowDay:  {bucketSize: time.Hour, bucketCount: 24, halfLife: 6 * time.Hour},
+	models.TrendWindowWeek: {bucketSize: 6 * time.Hour, bucketCount: 28, halfLife: 48 * time.Hour},
+}
+
+type trendRepository struct {
+	redis *redis.Client
+}
+
+func NewTrendRepository(redis *redis.Client) trend.Repository {
+	return &trendRepository{redis: redis}
+}
+
+func (repo *trendRepository) IncrementHashtags(names []string, usedAt time.Time) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	pipe := repo.redis.TxPipeline()
+	for window, config := range windowConfigs {
+		key := bucketKey(window, usedAt.Truncate(config.bucketSize))
+		for _, name := range names {
+			pipe.ZIncrBy(ctx, key, 1, name)
+		}
+
+		pipe.Expire(ctx, key, config.bucketSize*time.Duration(config.bucketCount+1))
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (repo *trendRepository) GetTopHashtags(window models.TrendWindow, limit int, now time.Time) ([]*models.Trend, error) {
+	config, isExist := windowConfigs[window]
+	if !isExist {
+		return nil, fmt.Errorf("unknown trend window %s", window)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	keys := make([]string, config.bucketCount)
+	weights := make([]float64, config.bucketCount)
+	currentBucket := now.Truncate(config.bucketSize)
+	for i := 0; i < config.bucketCount; i++ {
+		bucketStart := currentBucket.Add(-time.Duration(i) * config.bucketSize)
+		keys[i] = bucketKey(window, bucketStart)
+		weights[i] = math.Pow(0.5, float64(now.Sub(bucketStart))/float64(config.halfLife))
+	}
+
+	scoreKey := fmt.Sprintf("%s:%s:score:%d", RedisKeyTrendingHashtagsPrefix, window, now.UnixNano())
+	countKey := fmt.Sprintf("%s:%s:count:%d", RedisKeyTrendingHashtagsPrefix, window, now.UnixNano())
+	defer repo.redis.Del(context.Background(), scoreKey, countKey)
+
+	pipe := repo.redis.TxPipeline()
+	pipe.ZUnionStore(ctx, scoreKey, &redis.ZStore{Keys: keys, Weights: weights})
+	pipe.ZUnionStore(ctx, countKey, &redis.ZStore{Keys: keys})
+	topScores := pipe.ZRevRangeWithScores(ctx, scoreKey, 0, int64(limit-1))
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipe = repo.redis.Pipeline()
+	counts := make([]*redis.FloatCmd, len(topScores.Val()))
+	for i, z := range topScores.Val() {
+		counts[i] = pipe.ZScore(ctx, countKey, z.Member.(string))
+	}
+
+	if len(counts) > 0 {
+		_, err = pipe.Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	trends := make([]*models.Trend, len(counts))
+	for i, z := range topScores.Val() {
+		trends[i] = &models.Trend{Name: z.Member.(string), Count: uint(counts[i].Val()), Score: z.Score}
+	}
+
+	return trends, nil
+}
+
+func bucketKey(window models.TrendWindow, bucketStart time.Time) string {
+	return fmt.Sprintf("%s:%s:%d", RedisKeyTrendingHashtagsPrefix, window, bucketStart.Unix())
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/trend"
+)
+
+type trendUsecase struct {
+	trendRepo trend.Repository
+}
+
+func NewTrendUsecase(trendRepo trend.Repository) trend.Usecase {
+	return &trendUsecase{trendRepo: trendRepo}
+}
+
+func (usecase *trendUsecase) GetTrends(window models.TrendWindow) ([]*models.Trend, error) {
+	switch window {
+	case models.TrendWindowHour, models.TrendWindowDay, models.TrendWindowWeek:
+		break
+	default:
+		return nil, custom_errors.ErrInvalidTrendWindow
+	}
+
+	return usecase.trendRepo.GetTopHashtags(window, trend.TrendingHashtagCount, time.Now())
+}
@@ -0,0 +1,54 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/trend"
+	trendMocks "github.com/jordyf15/tweeter-api/trend/mocks"
+	"github.com/jordyf15/tweeter-api/trend/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTrendUsecase(t *testing.T) {
+	suite.Run(t, new(trendUsecaseSuite))
+}
+
+type trendUsecaseSuite struct {
+	suite.Suite
+	usecase   trend.Usecase
+	trendRepo *trendMocks.Repository
+}
+
+var utTrends = []*models.Trend{
+	{Name: "golang", Count: 12, Score: 10.5},
+	{Name: "redis", Count: 4, Score: 3.2},
+}
+
+func (s *trendUsecaseSuite) SetupTest() {
+	s.trendRepo = new(trendMocks.Repository)
+
+	s.trendRepo.On("GetTopHashtags", mock.AnythingOfType("models.TrendWindow"), mock.AnythingOfType("int"), mock.AnythingOfType("time.Time")).Return(utTrends, nil)
+
+	s.usecase = usecase.NewTrendUsecase(s.trendRepo)
+}
+
+func (s *trendUsecaseSuite) TestGetTrendsInvalidWindow() {
+	trends, err := s.usecase.GetTrends(models.TrendWindow("30d"))
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), trends)
+	assert.Equal(s.T(), custom_errors.ErrInvalidTrendWindow.Error(), err.Error())
+	s.trendRepo.AssertNumberOfCalls(s.T(), "GetTopHashtags", 0)
+}
+
+func (s *trendUsecaseSuite) TestGetTrendsSuccessful() {
+	trends, err := s.usecase.GetTrends(models.TrendWindowWeek)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), utTrends, trends)
+	s.trendRepo.AssertCalled(s.T(), "GetTopHashtags", models.TrendWindowWeek, trend.TrendingHashtagCount, mock.AnythingOfType("time.Time"))
+}
@@ -0,0 +1,38 @@
+package tweet
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/utils"
+)
+
+var (
+	MaxImageCount = 4
+	ImageWidth    = uint(1200)
+	ImageHeight   = uint(675)
+)
+
+type Usecase interface {
+	Create(tweet *models.Tweet, images []utils.NamedFileReader) (*models.Tweet, error)
+	Get(tweetID, viewerID string) (*models.Tweet, error)
+	Delete(tweetID, userID string) error
+	VerifyReplyPermission(tweetID, userID string) error
+	VerifyViewPermission(tweetID, viewerID string) error
+	VerifyUserViewPermission(userID, viewerID string) error
+	FilterViewableTweets(viewerID string, tweets []*models.Tweet) ([]*models.Tweet, error)
+	FilterMutedTweets(viewerID string, tweets []*models.Tweet) ([]*models.Tweet, error)
+	AssignUsers(tweets []*models.Tweet) error
+	GetUserTweets(viewerID, userID string, filter models.UserTweetFilter, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error)
+}
+
+type Repository interface {
+	Create(tweet *models.Tweet) error
+	CreateTransaction(fn func(repo Repository) error) error
+	GetByID(id string) (*models.Tweet, error)
+	GetByIDs(ids []string) ([]*models.Tweet, error)
+	GetByUserID(userID string, withImagesOnly bool, cursor *utils.Cursor, limit int) ([]*models.Tweet, error)
+	GetByHashtag(hashtagID string, cursor *utils.Cursor, limit int) ([]*models.Tweet, error)
+	GetHomeTimeline(userID string, excludedUserIDs []string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, error)
+	GetUsersTimeline(userIDs []string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, error)
+	Delete(id string) error
+	SetHashtags(tweetID string, names []string) error
+}
@@ -0,0 +1,226 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	tweet "github.com/jordyf15/tweeter-api/tweet"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0
+func (_m *Repository) Create(_a0 *models.Tweet) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Tweet) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTransaction provides a mock function with given fields: fn
+func (_m *Repository) CreateTransaction(fn func(tweet.Repository) error) error {
+	ret := _m.Called(fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(tweet.Repository) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *Repository) Delete(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByHashtag provides a mock function with given fields: hashtagID, cursor, limit
+func (_m *Repository) GetByHashtag(hashtagID string, cursor *utils.Cursor, limit int) ([]*models.Tweet, error) {
+	ret := _m.Called(hashtagID, cursor, limit)
+
+	var r0 []*models.Tweet
+	if rf, ok := ret.Get(0).(func(string, *utils.Cursor, int) []*models.Tweet); ok {
+		r0 = rf(hashtagID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tweet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *utils.Cursor, int) error); ok {
+		r1 = rf(hashtagID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *Repository) GetByID(id string) (*models.Tweet, error) {
+	ret := _m.Called(id)
+
+	var r0 *models.Tweet
+	if rf, ok := ret.Get(0).(func(string) *models.Tweet); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tweet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDs provides a mock function with given fields: ids
+func (_m *Repository) GetByIDs(ids []string) ([]*models.Tweet, error) {
+	ret := _m.Called(ids)
+
+	var r0 []*models.Tweet
+	if rf, ok := ret.Get(0).(func([]string) []*models.Tweet); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tweet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUserID provides a mock function with given fields: userID, withImagesOnly, cursor, limit
+func (_m *Repository) GetByUserID(userID string, withImagesOnly bool, cursor *utils.Cursor, limit int) ([]*models.Tweet, error) {
+	ret := _m.Called(userID, withImagesOnly, cursor, limit)
+
+	var r0 []*models.Tweet
+	if rf, ok := ret.Get(0).(func(string, bool, *utils.Cursor, int) []*models.Tweet); ok {
+		r0 = rf(userID, withImagesOnly, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tweet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool, *utils.Cursor, int) error); ok {
+		r1 = rf(userID, withImagesOnly, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHomeTimeline provides a mock function with given fields: userID, excludedUserIDs, cursor, limit
+func (_m *Repository) GetHomeTimeline(userID string, excludedUserIDs []string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, error) {
+	ret := _m.Called(userID, excludedUserIDs, cursor, limit)
+
+	var r0 []*models.TimelineEntry
+	if rf, ok := ret.Get(0).(func(string, []string, *utils.Cursor, int) []*models.TimelineEntry); ok {
+		r0 = rf(userID, excludedUserIDs, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TimelineEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []string, *utils.Cursor, int) error); ok {
+		r1 = rf(userID, excludedUserIDs, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUsersTimeline provides a mock function with given fields: userIDs, cursor, limit
+func (_m *Repository) GetUsersTimeline(userIDs []string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, error) {
+	ret := _m.Called(userIDs, cursor, limit)
+
+	var r0 []*models.TimelineEntry
+	if rf, ok := ret.Get(0).(func([]string, *utils.Cursor, int) []*models.TimelineEntry); ok {
+		r0 = rf(userIDs, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TimelineEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, *utils.Cursor, int) error); ok {
+		r1 = rf(userIDs, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetHashtags provides a mock function with given fields: tweetID, names
+func (_m *Repository) SetHashtags(tweetID string, names []string) error {
+	ret := _m.Called(tweetID, names)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(tweetID, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,224 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jordyf15/tweeter-api/models"
+	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/jordyf15/tweeter-api/utils"
+)
+
+// Usecase is an autogenerated mock type for the Usecase type
+type Usecase struct {
+	mock.Mock
+}
+
+// AssignUsers provides a mock function with given fields: tweets
+func (_m *Usecase) AssignUsers(tweets []*models.Tweet) error {
+	ret := _m.Called(tweets)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*models.Tweet) error); ok {
+		r0 = rf(tweets)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: _a0, images
+func (_m *Usecase) Create(_a0 *models.Tweet, images []utils.NamedFileReader) (*models.Tweet, error) {
+	ret := _m.Called(_a0, images)
+
+	var r0 *models.Tweet
+	if rf, ok := ret.Get(0).(func(*models.Tweet, []utils.NamedFileReader) *models.Tweet); ok {
+		r0 = rf(_a0, images)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tweet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Tweet, []utils.NamedFileReader) error); ok {
+		r1 = rf(_a0, images)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: tweetID, userID
+func (_m *Usecase) Delete(tweetID string, userID string) error {
+	ret := _m.Called(tweetID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(tweetID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilterMutedTweets provides a mock function with given fields: viewerID, tweets
+func (_m *Usecase) FilterMutedTweets(viewerID string, tweets []*models.Tweet) ([]*models.Tweet, error) {
+	ret := _m.Called(viewerID, tweets)
+
+	var r0 []*models.Tweet
+	if rf, ok := ret.Get(0).(func(string, []*models.Tweet) []*models.Tweet); ok {
+		r0 = rf(viewerID, tweets)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tweet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []*models.Tweet) error); ok {
+		r1 = rf(viewerID, tweets)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilterViewableTweets provides a mock function with given fields: viewerID, tweets
+func (_m *Usecase) FilterViewableTweets(viewerID string, tweets []*models.Tweet) ([]*models.Tweet, error) {
+	ret := _m.Called(viewerID, tweets)
+
+	var r0 []*models.Tweet
+	if rf, ok := ret.Get(0).(func(string, []*models.Tweet) []*models.Tweet); ok {
+		r0 = rf(viewerID, tweets)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tweet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []*models.Tweet) error); ok {
+		r1 = rf(viewerID, tweets)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: tweetID, viewerID
+func (_m *Usecase) Get(tweetID string, viewerID string) (*models.Tweet, error) {
+	ret := _m.Called(tweetID, viewerID)
+
+	var r0 *models.Tweet
+	if rf, ok := ret.Get(0).(func(string, string) *models.Tweet); ok {
+		r0 = rf(tweetID, viewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tweet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(tweetID, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserTweets provides a mock function with given fields: viewerID, userID, filter, cursor, limit
+func (_m *Usecase) GetUserTweets(viewerID string, userID string, filter models.UserTweetFilter, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error) {
+	ret := _m.Called(viewerID, userID, filter, cursor, limit)
+
+	var r0 []*models.Tweet
+	if rf, ok := ret.Get(0).(func(string, string, models.UserTweetFilter, *utils.Cursor, int) []*models.Tweet); ok {
+		r0 = rf(viewerID, userID, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tweet)
+		}
+	}
+
+	var r1 *utils.Cursor
+	if rf, ok := ret.Get(1).(func(string, string, models.UserTweetFilter, *utils.Cursor, int) *utils.Cursor); ok {
+		r1 = rf(viewerID, userID, filter, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*utils.Cursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, models.UserTweetFilter, *utils.Cursor, int) error); ok {
+		r2 = rf(viewerID, userID, filter, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// VerifyReplyPermission provides a mock function with given fields: tweetID, userID
+func (_m *Usecase) VerifyReplyPermission(tweetID string, userID string) error {
+	ret := _m.Called(tweetID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(tweetID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyUserViewPermission provides a mock function with given fields: userID, viewerID
+func (_m *Usecase) VerifyUserViewPermission(userID string, viewerID string) error {
+	ret := _m.Called(userID, viewerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, viewerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyViewPermission provides a mock function with given fields: tweetID, viewerID
+func (_m *Usecase) VerifyViewPermission(tweetID string, viewerID string) error {
+	ret := _m.Called(tweetID, viewerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(tweetID, viewerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewUsecase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUsecase creates a new instance of Usecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUsecase(t mockConstructorTestingTNewUsecase) *Usecase {
+	mock := &Usecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
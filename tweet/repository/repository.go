@@ -0,0 +1,147 @@
+package repository
+
+import (
+	hr "github.com/jordyf15/tweeter-api/hashtag/repository"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+)
+
+type tweetRepository struct {
+	DB *gorm.DB
+}
+
+func NewTweetRepository(db *gorm.DB) tweet.Repository {
+	return &tweetRepository{DB: db}
+}
+
+func (repo *tweetRepository) Create(tweet *models.Tweet) error {
+	return repo.DB.Create(tweet).Error
+}
+
+func (repo *tweetRepository) CreateTransaction(fn func(repo tweet.Repository) error) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(&tweetRepository{DB: tx})
+	})
+}
+
+func (repo *tweetRepository) GetByID(id string) (*models.Tweet, error) {
+	tweet := &models.Tweet{}
+
+	err := repo.DB.Table("tweets").Where("id = ?", id).First(tweet).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tweet, nil
+}
+
+func (repo *tweetRepository) GetByIDs(ids []string) ([]*models.Tweet, error) {
+	tweets := make([]*models.Tweet, 0)
+	if len(ids) == 0 {
+		return tweets, nil
+	}
+
+	err := repo.DB.Table("tweets").Where("id IN ?", ids).Find(&tweets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tweets, nil
+}
+
+// GetByUserID returns the tweets posted by the user, newest first.
+// When withImagesOnly is true only tweets with at least one image are returned.
+func (repo *tweetRepository) GetByUserID(userID string, withImagesOnly bool, cursor *utils.Cursor, limit int) ([]*models.Tweet, error) {
+	tweets := make([]*models.Tweet, 0)
+
+	query := repo.DB.Table("tweets").Where("user_id = ?", userID)
+	if withImagesOnly {
+		query = query.Where("jsonb_array_length(images) > 0")
+	}
+
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&tweets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tweets, nil
+}
+
+func (repo *tweetRepository) GetByHashtag(hashtagID string, cursor *utils.Cursor, limit int) ([]*models.Tweet, error) {
+	tweets := make([]*models.Tweet, 0)
+
+	query := repo.DB.Table("tweets").
+		Joins("JOIN tag_references ON tag_references.resource_id = tweets.id AND tag_references.resource_type = ?", models.TagResourceTypeTweet).
+		Where("tag_references.tag_id = ?", hashtagID)
+	if cursor != nil {
+		query = query.Where("(tweets.created_at, tweets.id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Select("tweets.*").Order("tweets.created_at DESC, tweets.id DESC").Limit(limit).Find(&tweets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tweets, nil
+}
+
+// GetHomeTimeline returns the tweets posted and retweeted by the user and the accounts they follow,
+// leaving out those of excludedUserIDs.
+// Retweet entries are identified by "tweet_id:retweeter_id" so that they can be paginated together with tweets.
+func (repo *tweetRepository) GetHomeTimeline(userID string, excludedUserIDs []string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, error) {
+	followings := repo.DB.Table("follows").Select("following_id").Where("follower_id = ?", userID)
+
+	if len(excludedUserIDs) > 0 {
+		return repo.getTimeline(cursor, limit, "(user_id = ? OR user_id IN (?)) AND user_id NOT IN ?", userID, followings, excludedUserIDs)
+	}
+
+	return repo.getTimeline(cursor, limit, "user_id = ? OR user_id IN (?)", userID, followings)
+}
+
+// GetUsersTimeline returns the tweets posted and retweeted by the given users.
+func (repo *tweetRepository) GetUsersTimeline(userIDs []string, cursor *utils.Cursor, limit int) ([]*models.TimelineEntry, error) {
+	if len(userIDs) == 0 {
+		return make([]*models.TimelineEntry, 0), nil
+	}
+
+	return repo.getTimeline(cursor, limit, "user_id IN ?", userIDs)
+}
+
+// getTimeline returns the tweets and retweets whose user_id matches the given condition.
+func (repo *tweetRepository) getTimeline(cursor *utils.Cursor, limit int, userCondition string, args ...interface{}) ([]*models.TimelineEntry, error) {
+	entries := make([]*models.TimelineEntry, 0)
+
+	tweets := repo.DB.Table("tweets").
+		Select("tweets.id::text COLLATE \"C\" AS id, tweets.id AS tweet_id, tweets.user_id, NULL::uuid AS retweeter_id, tweets.created_at").
+		Where(userCondition, args...)
+
+	retweets := repo.DB.Table("retweets").
+		Select("(retweets.tweet_id::text || ':' || retweets.user_id::text) COLLATE \"C\" AS id, retweets.tweet_id, retweets.user_id, retweets.user_id AS retweeter_id, retweets.created_at").
+		Where(userCondition, args...)
+
+	query := repo.DB.Table("((?) UNION ALL (?)) AS entries", tweets, retweets)
+	if cursor != nil {
+		query = query.Where("(entries.created_at, entries.id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("entries.created_at DESC, entries.id DESC").Limit(limit).Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (repo *tweetRepository) Delete(id string) error {
+	return repo.DB.Where("id = ?", id).Delete(&models.Tweet{}).Error
+}
+
+func (repo *tweetRepository) SetHashtags(tweetID string, names []string) error {
+	return hr.NewHashtagRepository(repo.DB).SyncReferences(tweetID, models.TagResourceTypeTweet, names)
+}
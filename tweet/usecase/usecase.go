@@ -0,0 +1,451 @@
+package usecase
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jordyf15/tweeter-api/block"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/follow"
+	"github.com/jordyf15/tweeter-api/like"
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/mute"
+	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/timeline"
+	"github.com/jordyf15/tweeter-api/trend"
+	"github.com/jordyf15/tweeter-api/tweet"
+	"github.com/jordyf15/tweeter-api/user"
+	"github.com/jordyf15/tweeter-api/utils"
+	"gorm.io/gorm"
+)
+
+type tweetUsecase struct {
+	tweetRepo       tweet.Repository
+	followRepo      follow.Repository
+	userRepo        user.Repository
+	likeRepo        like.Repository
+	blockRepo       block.Repository
+	muteRepo        mute.Repository
+	trendRepo       trend.Repository
+	timelineUsecase timeline.Usecase
+	storage         storage.Storage
+}
+
+func NewTweetUsecase(tweetRepo tweet.Repository, followRepo follow.Repository, userRepo user.Repository, likeRepo like.Repository, blockRepo block.Repository, muteRepo mute.Repository, trendRepo trend.Repository, timelineUsecase timeline.Usecase, storage storage.Storage) tweet.Usecase {
+	return &tweetUsecase{tweetRepo: tweetRepo, followRepo: followRepo, userRepo: userRepo, likeRepo: likeRepo, blockRepo: blockRepo, muteRepo: muteRepo, trendRepo: trendRepo, timelineUsecase: timelineUsecase, storage: storage}
+}
+
+func (usecase *tweetUsecase) Create(_tweet *models.Tweet, imageReaders []utils.NamedFileReader) (*models.Tweet, error) {
+	errors := make([]error, 0)
+
+	if len(_tweet.ReplyConstraint) == 0 {
+		_tweet.ReplyConstraint = models.TweetReplyConstraintEveryone
+	}
+
+	validateFieldErrors := _tweet.VerifyFields()
+	if len(validateFieldErrors) > 0 {
+		errors = append(errors, validateFieldErrors...)
+	}
+
+	if len(imageReaders) > tweet.MaxImageCount {
+		errors = append(errors, custom_errors.ErrTweetImageCountExceeded)
+	}
+
+	if !utils.IsImageFormatValid(imageReaders) {
+		errors = append(errors, custom_errors.ErrTweetImageInvalidFormat)
+	}
+
+	if len(errors) > 0 {
+		return nil, &custom_errors.MultipleErrors{Errors: errors}
+	}
+
+	hashtags := utils.ExtractHashtags(_tweet.Description)
+
+	err := usecase.tweetRepo.CreateTransaction(func(repo tweet.Repository) error {
+		_tweet.ID = uuid.New().String()
+		_tweet.Images = make([]*models.Image, len(imageReaders))
+
+		for i, imageReader := range imageReaders {
+			image := &models.Image{}
+			image.Filename = utils.RandFileName("", "."+utils.GetFileExtension(imageReader.Name()))
+			image.Width = tweet.ImageWidth
+			image.Height = tweet.ImageHeight
+
+			_tweet.Images[i] = image
+		}
+
+		err := repo.Create(_tweet)
+		if err != nil {
+			return err
+		}
+
+		err = repo.SetHashtags(_tweet.ID, hashtags)
+		if err != nil {
+			return err
+		}
+
+		return storage.UploadImages(usecase.storage, imageReaders, _tweet.Images, _tweet.ImagePath)
+	})
+
+	if err != nil {
+		switch actualErr := err.(type) {
+		case *custom_errors.MultipleErrors:
+			errors = append(errors, actualErr.Errors...)
+		default:
+			errors = append(errors, err)
+		}
+	}
+
+	if len(errors) > 0 {
+		return nil, &custom_errors.MultipleErrors{Errors: errors}
+	}
+
+	if len(hashtags) > 0 {
+		err = usecase.trendRepo.IncrementHashtags(hashtags, time.Now())
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	_tweet.User, err = usecase.userRepo.GetByID(_tweet.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = usecase.timelineUsecase.PushEntry(_tweet.User, &models.TimelineEntry{ID: _tweet.ID, TweetID: _tweet.ID, UserID: _tweet.UserID, CreatedAt: _tweet.CreatedAt})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	usecase.storage.AssignImageURLToUser(_tweet.User)
+	usecase.storage.AssignImageURLToTweet(_tweet)
+
+	return _tweet, nil
+}
+
+func (usecase *tweetUsecase) Get(tweetID, viewerID string) (*models.Tweet, error) {
+	_tweet, err := usecase.tweetRepo.GetByID(tweetID)
+	if err != nil {
+		return nil, err
+	}
+
+	_tweet.User, err = usecase.userRepo.GetByID(_tweet.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = usecase.verifyCanView(viewerID, _tweet.User)
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.storage.AssignImageURLToUser(_tweet.User)
+	usecase.storage.AssignImageURLToTweet(_tweet)
+
+	return _tweet, nil
+}
+
+func (usecase *tweetUsecase) Delete(tweetID, userID string) error {
+	_tweet, err := usecase.tweetRepo.GetByID(tweetID)
+	if err != nil {
+		return err
+	}
+
+	if _tweet.UserID != userID {
+		return custom_errors.ErrTweetDeleteForbidden
+	}
+
+	return usecase.tweetRepo.CreateTransaction(func(repo tweet.Repository) error {
+		err := repo.Delete(_tweet.ID)
+		if err != nil {
+			return err
+		}
+
+		removeChannels := make(chan error, len(_tweet.Images))
+		var wg sync.WaitGroup
+		wg.Add(len(_tweet.Images))
+
+		for _, image := range _tweet.Images {
+			go usecase.storage.RemoveFile(removeChannels, &wg, _tweet.ImagePath(image))
+		}
+
+		wg.Wait()
+		close(removeChannels)
+
+		for err := range removeChannels {
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (usecase *tweetUsecase) VerifyReplyPermission(tweetID, userID string) error {
+	_tweet, err := usecase.tweetRepo.GetByID(tweetID)
+	if err != nil {
+		return err
+	}
+
+	if _tweet.UserID == userID {
+		return nil
+	}
+
+	author, err := usecase.userRepo.GetByID(_tweet.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = usecase.verifyCanView(userID, author)
+	if err != nil {
+		return err
+	}
+
+	if _tweet.ReplyConstraint != models.TweetReplyConstraintFollowingOnly {
+		return nil
+	}
+
+	isFollowed, err := usecase.followRepo.IsFollowing(_tweet.UserID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !isFollowed {
+		return custom_errors.ErrTweetReplyRestricted
+	}
+
+	return nil
+}
+
+// VerifyViewPermission returns an error when the viewer is not allowed to see the tweet.
+func (usecase *tweetUsecase) VerifyViewPermission(tweetID, viewerID string) error {
+	_tweet, err := usecase.tweetRepo.GetByID(tweetID)
+	if err != nil {
+		return err
+	}
+
+	if _tweet.UserID == viewerID {
+		return nil
+	}
+
+	author, err := usecase.userRepo.GetByID(_tweet.UserID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.verifyCanView(viewerID, author)
+}
+
+// VerifyUserViewPermission returns an error when the viewer is not allowed to see what the user posts.
+func (usecase *tweetUsecase) VerifyUserViewPermission(userID, viewerID string) error {
+	_user, err := usecase.userRepo.GetByID(userID)
+	if err == gorm.ErrRecordNotFound {
+		return custom_errors.ErrRecordNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return usecase.verifyCanView(viewerID, _user)
+}
+
+// FilterViewableTweets drops the tweets whose authors have a block with the viewer or are
+// protected and not followed by the viewer, keeping the order of the rest.
+func (usecase *tweetUsecase) FilterViewableTweets(viewerID string, tweets []*models.Tweet) ([]*models.Tweet, error) {
+	authorIDs := make([]string, 0, len(tweets))
+	for _, _tweet := range tweets {
+		authorIDs = append(authorIDs, _tweet.UserID)
+	}
+
+	blockedUserIDs, err := usecase.blockRepo.GetBlockedUserIDs(viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	protectedUserIDs, err := usecase.followRepo.GetProtectedIDsNotFollowedAmong(viewerID, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	isHidden := make(map[string]bool, len(blockedUserIDs)+len(protectedUserIDs))
+	for _, hiddenUserID := range append(blockedUserIDs, protectedUserIDs...) {
+		isHidden[hiddenUserID] = true
+	}
+
+	viewableTweets := make([]*models.Tweet, 0, len(tweets))
+	for _, _tweet := range tweets {
+		if !isHidden[_tweet.UserID] {
+			viewableTweets = append(viewableTweets, _tweet)
+		}
+	}
+
+	return viewableTweets, nil
+}
+
+// FilterMutedTweets drops the tweets whose authors are muted by the viewer or that contain
+// a keyword muted by the viewer, keeping the order of the rest.
+func (usecase *tweetUsecase) FilterMutedTweets(viewerID string, tweets []*models.Tweet) ([]*models.Tweet, error) {
+	muteFilter, err := mute.GetFilter(usecase.muteRepo, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	unmutedTweets := make([]*models.Tweet, 0, len(tweets))
+	for _, _tweet := range tweets {
+		if !muteFilter.IsTweetMuted(_tweet) {
+			unmutedTweets = append(unmutedTweets, _tweet)
+		}
+	}
+
+	return unmutedTweets, nil
+}
+
+// GetUserTweets returns the tweets shown on the user's profile for the given filter.
+// Replies are comments and are served by the comment usecase instead. Tweets of users who
+// have a block with the viewer, or who are protected and not followed by the viewer, are not shown.
+func (usecase *tweetUsecase) GetUserTweets(viewerID, userID string, filter models.UserTweetFilter, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error) {
+	if filter != models.UserTweetFilterTweets && filter != models.UserTweetFilterMedia && filter != models.UserTweetFilterLikes {
+		return nil, nil, custom_errors.ErrInvalidUserTweetFilter
+	}
+
+	err := usecase.VerifyUserViewPermission(userID, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if filter == models.UserTweetFilterLikes {
+		return usecase.getLikedTweets(viewerID, userID, cursor, limit)
+	}
+
+	tweets, err := usecase.tweetRepo.GetByUserID(userID, filter == models.UserTweetFilterMedia, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(tweets) > limit {
+		tweets = tweets[:limit]
+		lastTweet := tweets[limit-1]
+		nextCursor = utils.NewCursor(lastTweet.CreatedAt, lastTweet.ID)
+	}
+
+	err = usecase.AssignUsers(tweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tweets, nextCursor, nil
+}
+
+// getLikedTweets returns the tweets liked by the user, most recently liked first.
+// The cursor is based on when the tweets were liked rather than when they were posted.
+// Tweets the viewer cannot see or has muted are left out.
+func (usecase *tweetUsecase) getLikedTweets(viewerID, userID string, cursor *utils.Cursor, limit int) ([]*models.Tweet, *utils.Cursor, error) {
+	likes, err := usecase.likeRepo.GetByUserID(userID, models.LikeResourceTypeTweet, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *utils.Cursor
+	if len(likes) > limit {
+		likes = likes[:limit]
+		lastLike := likes[limit-1]
+		nextCursor = utils.NewCursor(lastLike.CreatedAt, lastLike.ResourceID)
+	}
+
+	tweetIDs := make([]string, 0, len(likes))
+	for _, _like := range likes {
+		tweetIDs = append(tweetIDs, _like.ResourceID)
+	}
+
+	tweets, err := usecase.tweetRepo.GetByIDs(tweetIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tweets, err = usecase.FilterViewableTweets(viewerID, tweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tweets, err = usecase.FilterMutedTweets(viewerID, tweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tweetsByID := make(map[string]*models.Tweet, len(tweets))
+	for _, _tweet := range tweets {
+		tweetsByID[_tweet.ID] = _tweet
+	}
+
+	likedTweets := make([]*models.Tweet, 0, len(likes))
+	for _, _like := range likes {
+		_tweet, isExist := tweetsByID[_like.ResourceID]
+		if !isExist {
+			continue
+		}
+
+		likedTweets = append(likedTweets, _tweet)
+	}
+
+	err = usecase.AssignUsers(likedTweets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return likedTweets, nextCursor, nil
+}
+
+// verifyCanView returns ErrUserBlocked when either user has blocked the other, and
+// ErrTweetProtected when the author is protected and the viewer does not follow them.
+func (usecase *tweetUsecase) verifyCanView(viewerID string, author *models.User) error {
+	if viewerID == author.ID {
+		return nil
+	}
+
+	isBlocked, err := usecase.blockRepo.IsBlockedBetween(viewerID, author.ID)
+	if err != nil {
+		return err
+	}
+
+	if isBlocked {
+		return custom_errors.ErrUserBlocked
+	}
+
+	if !author.IsProtected {
+		return nil
+	}
+
+	isFollowing, err := usecase.followRepo.IsFollowing(viewerID, author.ID)
+	if err != nil {
+		return err
+	}
+
+	if !isFollowing {
+		return custom_errors.ErrTweetProtected
+	}
+
+	return nil
+}
+
+// AssignUsers loads the authors of the tweets and assigns the image URLs of the tweets.
+func (usecase *tweetUsecase) AssignUsers(tweets []*models.Tweet) error {
+	userIDs := make([]string, 0, len(tweets))
+	for _, _tweet := range tweets {
+		userIDs = append(userIDs, _tweet.UserID)
+	}
+
+	usersByID, err := user.GetUsersByID(usecase.userRepo, usecase.storage, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, _tweet := range tweets {
+		_tweet.User = usersByID[_tweet.UserID]
+		usecase.storage.AssignImageURLToTweet(_tweet)
+	}
+
+	return nil
+}
@@ -0,0 +1,576 @@
+package usecase_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	blockMocks "github.com/jordyf15/tweeter-api/block/mocks"
+	"github.com/jordyf15/tweeter-api/custom_errors"
+	followMocks "github.com/jordyf15/tweeter-api/follow/mocks"
+	likeMocks "github.com/jordyf15/tweeter-api/like/mocks"
+	"github.com/jordyf15/tweeter-api/models"
+	muteMocks "github.com/jordyf15/tweeter-api/mute/mocks"
+	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	timelineMocks "github.com/jordyf15/tweeter-api/timeline/mocks"
+	trendMocks "github.com/jordyf15/tweeter-api/trend/mocks"
+	"github.com/jordyf15/tweeter-api/tweet"
+	tweetMocks "github.com/jordyf15/tweeter-api/tweet/mocks"
+	"github.com/jordyf15/tweeter-api/tweet/usecase"
+	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
+	"github.com/jordyf15/tweeter-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestTweetUsecase(t *testing.T) {
+	suite.Run(t, new(tweetUsecaseSuite))
+}
+
+type tweetUsecaseSuite struct {
+	suite.Suite
+	usecase         tweet.Usecase
+	tweetRepo       *tweetMocks.Repository
+	followRepo      *followMocks.Repository
+	userRepo        *userMocks.Repository
+	likeRepo        *likeMocks.Repository
+	blockRepo       *blockMocks.Repository
+	muteRepo        *muteMocks.Repository
+	trendRepo       *trendMocks.Repository
+	timelineUsecase *timelineMocks.Usecase
+	storageMock     *storageMocks.Storage
+}
+
+var (
+	utUser = &models.User{
+		ID:       "userID1",
+		Username: "username",
+	}
+
+	utTweets = []*models.Tweet{
+		{ID: "tweetID1", UserID: "userID1", Description: "tweet 1", CreatedAt: time.Now()},
+		{ID: "tweetID2", UserID: "userID1", Description: "tweet 2", CreatedAt: time.Now().Add(-time.Minute)},
+		{ID: "tweetID3", UserID: "userID1", Description: "tweet 3", CreatedAt: time.Now().Add(-time.Hour)},
+	}
+
+	utLikes = []*models.Like{
+		{UserID: "userID2", ResourceID: "tweetID3", ResourceType: models.LikeResourceTypeTweet, CreatedAt: time.Now()},
+		{UserID: "userID2", ResourceID: "tweetID4", ResourceType: models.LikeResourceTypeTweet, CreatedAt: time.Now().Add(-time.Minute)},
+		{UserID: "userID2", ResourceID: "tweetID1", ResourceType: models.LikeResourceTypeTweet, CreatedAt: time.Now().Add(-time.Hour)},
+	}
+)
+
+func (s *tweetUsecaseSuite) SetupTest() {
+	s.tweetRepo = new(tweetMocks.Repository)
+	s.followRepo = new(followMocks.Repository)
+	s.userRepo = new(userMocks.Repository)
+	s.likeRepo = new(likeMocks.Repository)
+	s.blockRepo = new(blockMocks.Repository)
+	s.muteRepo = new(muteMocks.Repository)
+	s.trendRepo = new(trendMocks.Repository)
+	s.timelineUsecase = new(timelineMocks.Usecase)
+	s.storageMock = new(storageMocks.Storage)
+
+	getTweetByID := func(tweetID string) *models.Tweet {
+		replyConstraint := models.TweetReplyConstraintEveryone
+		if tweetID == "tweetID2" {
+			replyConstraint = models.TweetReplyConstraintFollowingOnly
+		}
+
+		userID := "userID1"
+		if tweetID == "tweetID6" {
+			userID = "userID6"
+		}
+
+		return &models.Tweet{ID: tweetID, UserID: userID, Description: "description", ReplyConstraint: replyConstraint, Images: models.Images{{Filename: "image.jpg"}}}
+	}
+
+	isFollowing := func(followerID, followingID string) bool {
+		return (followerID == "userID1" && followingID == "userID2") || (followerID == "userID2" && followingID == "userID6")
+	}
+
+	// userID4 does not exist and userID6 is protected
+	getUserByID := func(userID string) *models.User {
+		switch userID {
+		case "userID4":
+			return nil
+		case "userID6":
+			return &models.User{ID: "userID6", Username: "protected", IsProtected: true}
+		}
+
+		return utUser
+	}
+
+	getUserByIDErr := func(userID string) error {
+		if userID == "userID4" {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	}
+
+	getProtectedIDsNotFollowedAmong := func(viewerID string, userIDs []string) []string {
+		protectedIDs := make([]string, 0)
+		for _, userID := range userIDs {
+			if userID == "userID6" && viewerID != "userID2" && viewerID != "userID6" {
+				protectedIDs = append(protectedIDs, userID)
+			}
+		}
+
+		return protectedIDs
+	}
+
+	// userID5 has blocked userID1
+	isBlockedBetween := func(userID, otherUserID string) bool {
+		return (userID == "userID1" && otherUserID == "userID5") || (userID == "userID5" && otherUserID == "userID1")
+	}
+
+	createTransaction := func(fn func(repo tweet.Repository) error) error {
+		return fn(s.tweetRepo)
+	}
+
+	getTweetsByUserID := func(userID string, withImagesOnly bool, cursor *utils.Cursor, limit int) []*models.Tweet {
+		if limit > len(utTweets) {
+			return utTweets
+		}
+
+		return utTweets[:limit]
+	}
+
+	getTweetsByIDs := func(ids []string) []*models.Tweet {
+		tweets := make([]*models.Tweet, 0)
+		for _, _tweet := range utTweets {
+			for _, id := range ids {
+				if _tweet.ID == id {
+					tweets = append(tweets, _tweet)
+					break
+				}
+			}
+		}
+
+		return tweets
+	}
+
+	getLikesByUserID := func(userID string, resourceType models.LikeResourceType, cursor *utils.Cursor, limit int) []*models.Like {
+		if limit > len(utLikes) {
+			return utLikes
+		}
+
+		return utLikes[:limit]
+	}
+
+	s.tweetRepo.On("CreateTransaction", mock.Anything).Return(createTransaction)
+	s.tweetRepo.On("Create", mock.AnythingOfType("*models.Tweet")).Return(nil)
+	s.tweetRepo.On("GetByID", mock.AnythingOfType("string")).Return(getTweetByID, nil)
+	s.tweetRepo.On("GetByUserID", mock.AnythingOfType("string"), mock.AnythingOfType("bool"), mock.Anything, mock.AnythingOfType("int")).Return(getTweetsByUserID, nil)
+	s.tweetRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return(getTweetsByIDs, nil)
+	s.tweetRepo.On("Delete", mock.AnythingOfType("string")).Return(nil)
+	s.tweetRepo.On("SetHashtags", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return(nil)
+	s.followRepo.On("IsFollowing", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isFollowing, nil)
+	s.followRepo.On("GetProtectedIDsNotFollowedAmong", mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return(getProtectedIDsNotFollowedAmong, nil)
+	s.userRepo.On("GetByID", mock.AnythingOfType("string")).Return(getUserByID, getUserByIDErr)
+	s.userRepo.On("GetByIDs", mock.AnythingOfType("[]string")).Return([]*models.User{utUser}, nil)
+	s.likeRepo.On("GetByUserID", mock.AnythingOfType("string"), mock.AnythingOfType("models.LikeResourceType"), mock.Anything, mock.AnythingOfType("int")).Return(getLikesByUserID, nil)
+	s.blockRepo.On("IsBlockedBetween", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(isBlockedBetween, nil)
+	s.blockRepo.On("GetBlockedUserIDs", mock.AnythingOfType("string")).Return(func(userID string) []string {
+		if userID == "userID1" {
+			return []string{"userID5"}
+		}
+
+		return []string{}
+	}, nil)
+	s.muteRepo.On("GetActiveByUserID", mock.AnythingOfType("string")).Return(func(userID string) []*models.Mute {
+		if userID == "userID2" {
+			mutedUserID, keyword1, keyword2 := "userID3", "spoiler", "tweet 3"
+			return []*models.Mute{
+				{ID: "muteID1", UserID: "userID2", MutedUserID: &mutedUserID},
+				{ID: "muteID2", UserID: "userID2", Keyword: &keyword1},
+				{ID: "muteID3", UserID: "userID2", Keyword: &keyword2},
+			}
+		}
+
+		return []*models.Mute{}
+	}, nil)
+	s.trendRepo.On("IncrementHashtags", mock.AnythingOfType("[]string"), mock.AnythingOfType("time.Time")).Return(nil)
+	s.timelineUsecase.On("PushEntry", mock.AnythingOfType("*models.User"), mock.AnythingOfType("*models.TimelineEntry")).Return(nil)
+	s.storageMock.On("AssignImageURLToUser", mock.AnythingOfType("*models.User"))
+	s.storageMock.On("AssignImageURLToTweet", mock.AnythingOfType("*models.Tweet"))
+	s.storageMock.On("UploadFile", mock.AnythingOfType("chan<- error"), mock.AnythingOfType("*sync.WaitGroup"), mock.AnythingOfType("*os.File"), mock.AnythingOfType("string"), mock.AnythingOfType("map[string]string")).Run(func(args mock.Arguments) {
+		arg1 := args[0].(chan<- error)
+		arg1 <- nil
+		arg2 := args[1].(*sync.WaitGroup)
+		arg2.Done()
+	})
+	s.storageMock.On("RemoveFile", mock.AnythingOfType("chan<- error"), mock.AnythingOfType("*sync.WaitGroup"), mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+		arg1 := args[0].(chan<- error)
+		arg1 <- nil
+		arg2 := args[1].(*sync.WaitGroup)
+		arg2.Done()
+	})
+
+	s.usecase = usecase.NewTweetUsecase(s.tweetRepo, s.followRepo, s.userRepo, s.likeRepo, s.blockRepo, s.muteRepo, s.trendRepo, s.timelineUsecase, s.storageMock)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetDescriptionTooShort() {
+	_tweet := &models.Tweet{UserID: "userID1"}
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrTweetDescriptionTooShort}}
+	result, err := s.usecase.Create(_tweet, nil)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetDescriptionTooLong() {
+	_tweet := &models.Tweet{UserID: "userID1", Description: strings.Repeat("a", 281)}
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrTweetDescriptionTooLong}}
+	result, err := s.usecase.Create(_tweet, nil)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "Create", 0)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetReplyConstraintInvalid() {
+	_tweet := &models.Tweet{UserID: "userID1", Description: "description", ReplyConstraint: "nobody"}
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrTweetReplyConstraintInvalid}}
+	result, err := s.usecase.Create(_tweet, nil)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetImageCountExceeded() {
+	_tweet := &models.Tweet{UserID: "userID1", Description: "description"}
+
+	imgFile, _ := os.Open("../../assets/images/default-profile.png")
+	defer imgFile.Close()
+	imgFileReaders := make([]utils.NamedFileReader, 5)
+	for i := range imgFileReaders {
+		imgFileReaders[i] = utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name())))
+	}
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrTweetImageCountExceeded}}
+	result, err := s.usecase.Create(_tweet, imgFileReaders)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetImageInvalidFormat() {
+	_tweet := &models.Tweet{UserID: "userID1", Description: "description"}
+
+	imgFile, _ := os.Open("../../assets/images/test_pic.gif")
+	defer imgFile.Close()
+	imgFileReader := utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name())))
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrTweetImageInvalidFormat}}
+	result, err := s.usecase.Create(_tweet, []utils.NamedFileReader{imgFileReader})
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetImageResizeFailed() {
+	_tweet := &models.Tweet{UserID: "userID1", Description: "description"}
+
+	imgFile, _ := os.Open("../../assets/images/default-profile.png")
+	defer imgFile.Close()
+	imgFileReaders := []utils.NamedFileReader{
+		utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name()))),
+		utils.NewNamedFileReader(strings.NewReader("not an image"), "image.png"),
+	}
+
+	result, err := s.usecase.Create(_tweet, imgFileReaders)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	s.storageMock.AssertNumberOfCalls(s.T(), "UploadFile", 0)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetWithImagesSuccessful() {
+	_tweet := &models.Tweet{UserID: "userID1", Description: "description"}
+
+	imgFile, _ := os.Open("../../assets/images/default-profile.png")
+	defer imgFile.Close()
+	imgFileReaders := []utils.NamedFileReader{
+		utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name()))),
+		utils.NewNamedFileReader(imgFile, fmt.Sprintf("%s.%s", utils.RandString(8), utils.GetFileExtension(imgFile.Name()))),
+	}
+
+	result, err := s.usecase.Create(_tweet, imgFileReaders)
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), result)
+	assert.Len(s.T(), result.Images, 2)
+	assert.Equal(s.T(), tweet.ImageWidth, result.Images[0].Width)
+	assert.Equal(s.T(), tweet.ImageHeight, result.Images[0].Height)
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 1)
+	s.storageMock.AssertNumberOfCalls(s.T(), "UploadFile", 2)
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToTweet", 1)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetSuccessful() {
+	_tweet := &models.Tweet{UserID: "userID1", Description: "description"}
+
+	result, err := s.usecase.Create(_tweet, nil)
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), result)
+	assert.NotEmpty(s.T(), result.ID)
+	assert.Equal(s.T(), models.TweetReplyConstraintEveryone, result.ReplyConstraint)
+	assert.Equal(s.T(), utUser, result.User)
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "Create", 1)
+	s.trendRepo.AssertNumberOfCalls(s.T(), "IncrementHashtags", 0)
+	s.timelineUsecase.AssertCalled(s.T(), "PushEntry", utUser, &models.TimelineEntry{ID: result.ID, TweetID: result.ID, UserID: "userID1", CreatedAt: result.CreatedAt})
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToUser", 1)
+}
+
+func (s *tweetUsecaseSuite) TestCreateTweetWithHashtags() {
+	_tweet := &models.Tweet{UserID: "userID1", Description: "#Golang tips for #go and #golang, not a#tag, #2023 or &#39;"}
+
+	result, err := s.usecase.Create(_tweet, nil)
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), result)
+	s.tweetRepo.AssertCalled(s.T(), "SetHashtags", result.ID, []string{"golang", "go"})
+	s.trendRepo.AssertCalled(s.T(), "IncrementHashtags", []string{"golang", "go"}, mock.AnythingOfType("time.Time"))
+}
+
+func (s *tweetUsecaseSuite) TestGetTweetSuccessful() {
+	result, err := s.usecase.Get("tweetID1", "userID2")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "tweetID1", result.ID)
+	assert.Equal(s.T(), utUser, result.User)
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToUser", 1)
+}
+
+func (s *tweetUsecaseSuite) TestGetTweetBlocked() {
+	result, err := s.usecase.Get("tweetID1", "userID5")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToTweet", 0)
+}
+
+func (s *tweetUsecaseSuite) TestGetTweetProtectedNotFollowed() {
+	result, err := s.usecase.Get("tweetID6", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToTweet", 0)
+}
+
+func (s *tweetUsecaseSuite) TestGetTweetProtectedFollowed() {
+	result, err := s.usecase.Get("tweetID6", "userID2")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "tweetID6", result.ID)
+	s.followRepo.AssertCalled(s.T(), "IsFollowing", "userID2", "userID6")
+}
+
+func (s *tweetUsecaseSuite) TestDeleteTweetNotAuthor() {
+	err := s.usecase.Delete("tweetID1", "userID2")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrTweetDeleteForbidden.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "Delete", 0)
+}
+
+func (s *tweetUsecaseSuite) TestDeleteTweetSuccessful() {
+	err := s.usecase.Delete("tweetID1", "userID1")
+
+	assert.NoError(s.T(), err)
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "Delete", 1)
+	s.storageMock.AssertNumberOfCalls(s.T(), "RemoveFile", 1)
+}
+
+func (s *tweetUsecaseSuite) TestVerifyReplyPermissionEveryone() {
+	err := s.usecase.VerifyReplyPermission("tweetID1", "userID3")
+
+	assert.NoError(s.T(), err)
+	s.followRepo.AssertNumberOfCalls(s.T(), "IsFollowing", 0)
+}
+
+func (s *tweetUsecaseSuite) TestVerifyReplyPermissionBlocked() {
+	err := s.usecase.VerifyReplyPermission("tweetID1", "userID5")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+}
+
+func (s *tweetUsecaseSuite) TestVerifyReplyPermissionProtected() {
+	err := s.usecase.VerifyReplyPermission("tweetID6", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+}
+
+func (s *tweetUsecaseSuite) TestVerifyReplyPermissionFollowingOnlyAuthor() {
+	err := s.usecase.VerifyReplyPermission("tweetID2", "userID1")
+
+	assert.NoError(s.T(), err)
+	s.followRepo.AssertNumberOfCalls(s.T(), "IsFollowing", 0)
+}
+
+func (s *tweetUsecaseSuite) TestVerifyReplyPermissionFollowingOnlyNotFollowed() {
+	err := s.usecase.VerifyReplyPermission("tweetID2", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrTweetReplyRestricted.Error(), err.Error())
+	s.followRepo.AssertNumberOfCalls(s.T(), "IsFollowing", 1)
+}
+
+func (s *tweetUsecaseSuite) TestVerifyReplyPermissionFollowingOnlyFollowed() {
+	err := s.usecase.VerifyReplyPermission("tweetID2", "userID2")
+
+	assert.NoError(s.T(), err)
+	s.followRepo.AssertNumberOfCalls(s.T(), "IsFollowing", 1)
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsInvalidFilter() {
+	tweets, nextCursor, err := s.usecase.GetUserTweets("userID3", "userID1", models.UserTweetFilterReplies, nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), tweets)
+	assert.Nil(s.T(), nextCursor)
+	assert.Equal(s.T(), custom_errors.ErrInvalidUserTweetFilter.Error(), err.Error())
+	s.userRepo.AssertNumberOfCalls(s.T(), "GetByID", 0)
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsUserNotFound() {
+	tweets, _, err := s.usecase.GetUserTweets("userID3", "userID4", models.UserTweetFilterTweets, nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), tweets)
+	assert.Equal(s.T(), custom_errors.ErrRecordNotFound.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "GetByUserID", 0)
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsBlocked() {
+	tweets, _, err := s.usecase.GetUserTweets("userID5", "userID1", models.UserTweetFilterTweets, nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), tweets)
+	assert.Equal(s.T(), custom_errors.ErrUserBlocked.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "GetByUserID", 0)
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsProtected() {
+	tweets, _, err := s.usecase.GetUserTweets("userID3", "userID6", models.UserTweetFilterTweets, nil, 20)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), tweets)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+	s.tweetRepo.AssertNumberOfCalls(s.T(), "GetByUserID", 0)
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsProtectedOwner() {
+	_, _, err := s.usecase.GetUserTweets("userID6", "userID6", models.UserTweetFilterTweets, nil, 20)
+
+	assert.NoError(s.T(), err)
+	s.followRepo.AssertNumberOfCalls(s.T(), "IsFollowing", 0)
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsWithNextPage() {
+	tweets, nextCursor, err := s.usecase.GetUserTweets("userID3", "userID1", models.UserTweetFilterTweets, nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 2)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), utTweets[1].ID, nextCursor.ID)
+	assert.Equal(s.T(), utUser, tweets[0].User)
+	s.tweetRepo.AssertCalled(s.T(), "GetByUserID", "userID1", false, mock.Anything, 3)
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsMedia() {
+	tweets, nextCursor, err := s.usecase.GetUserTweets("userID3", "userID1", models.UserTweetFilterMedia, nil, 20)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 3)
+	assert.Nil(s.T(), nextCursor)
+	s.tweetRepo.AssertCalled(s.T(), "GetByUserID", "userID1", true, mock.Anything, 21)
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsLikesHidesMutedTweets() {
+	tweets, _, err := s.usecase.GetUserTweets("userID2", "userID2", models.UserTweetFilterLikes, nil, 20)
+
+	// userID2 has muted the keyword of tweetID3
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), tweets, 1)
+	assert.Equal(s.T(), "tweetID1", tweets[0].ID)
+	s.muteRepo.AssertCalled(s.T(), "GetActiveByUserID", "userID2")
+}
+
+func (s *tweetUsecaseSuite) TestGetUserTweetsLikes() {
+	tweets, nextCursor, err := s.usecase.GetUserTweets("userID3", "userID2", models.UserTweetFilterLikes, nil, 2)
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), nextCursor)
+	assert.Equal(s.T(), utLikes[1].ResourceID, nextCursor.ID)
+	assert.Equal(s.T(), utLikes[1].CreatedAt, nextCursor.CreatedAt)
+	s.likeRepo.AssertCalled(s.T(), "GetByUserID", "userID2", models.LikeResourceTypeTweet, mock.Anything, 3)
+
+	// tweetID4 has been deleted since it was liked
+	assert.Len(s.T(), tweets, 1)
+	assert.Equal(s.T(), "tweetID3", tweets[0].ID)
+	assert.Equal(s.T(), utUser, tweets[0].User)
+}
+
+func (s *tweetUsecaseSuite) TestVerifyViewPermissionProtected() {
+	err := s.usecase.VerifyViewPermission("tweetID6", "userID3")
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), custom_errors.ErrTweetProtected.Error(), err.Error())
+}
+
+func (s *tweetUsecaseSuite) TestFilterMutedTweets() {
+	tweets := []*models.Tweet{
+		{ID: "tweetID1", UserID: "userID1", Description: "hello"},
+		{ID: "tweetID2", UserID: "userID3", Description: "hi"},
+		{ID: "tweetID3", UserID: "userID1", Description: "no Spoiler please"},
+		{ID: "tweetID4", UserID: "userID2", Description: "my own spoiler"},
+	}
+
+	unmutedTweets, err := s.usecase.FilterMutedTweets("userID2", tweets)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), unmutedTweets, 2)
+	assert.Equal(s.T(), "tweetID1", unmutedTweets[0].ID)
+	assert.Equal(s.T(), "tweetID4", unmutedTweets[1].ID)
+	s.muteRepo.AssertCalled(s.T(), "GetActiveByUserID", "userID2")
+}
+
+func (s *tweetUsecaseSuite) TestFilterViewableTweets() {
+	tweets := []*models.Tweet{
+		{ID: "tweetID1", UserID: "userID1"},
+		{ID: "tweetID6", UserID: "userID6"},
+		{ID: "tweetID7", UserID: "userID5"},
+	}
+
+	viewableTweets, err := s.usecase.FilterViewableTweets("userID1", tweets)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), viewableTweets, 1)
+	assert.Equal(s.T(), "tweetID1", viewableTweets[0].ID)
+	s.followRepo.AssertCalled(s.T(), "GetProtectedIDsNotFollowedAmong", "userID1", []string{"userID1", "userID6", "userID5"})
+}
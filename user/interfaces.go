@@ -17,6 +17,8 @@ type Usecase interface {
 	Login(login, password string) (map[string]interface{}, error)
 	ChangeUserPassword(userID, oldPassword, newPassword string) error
 	EditUserProfile(userID string, updates map[string]string, profileImageReader, backgroundImageReader utils.NamedFileReader, willRemoveProfileImage, willRemoveBackgroundImage bool) (*models.User, error)
+	GetProfile(viewerID, userID string) (*models.UserProfile, error)
+	GetProfileByUsername(viewerID, username string) (*models.UserProfile, error)
 }
 
 type InstanceUsecase interface {
@@ -28,6 +30,9 @@ type Repository interface {
 	CreateTransaction(fn func(repo Repository) error) error
 	GetByEmailOrUsername(str string) (*models.User, error)
 	GetByID(id string) (*models.User, error)
+	GetByIDs(ids []string) ([]*models.User, error)
+	GetByUsername(username string) (*models.User, error)
 	IsIDExist(id string) (bool, error)
 	Update(user *models.User) error
+	ApproveAllFollowRequests(userID string) ([]string, error)
 }
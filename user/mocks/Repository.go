@@ -14,6 +14,29 @@ type Repository struct {
 	mock.Mock
 }
 
+// ApproveAllFollowRequests provides a mock function with given fields: userID
+func (_m *Repository) ApproveAllFollowRequests(userID string) ([]string, error) {
+	ret := _m.Called(userID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: _a0
 func (_m *Repository) Create(_a0 *models.User) error {
 	ret := _m.Called(_a0)
@@ -88,6 +111,52 @@ func (_m *Repository) GetByID(id string) (*models.User, error) {
 	return r0, r1
 }
 
+// GetByIDs provides a mock function with given fields: ids
+func (_m *Repository) GetByIDs(ids []string) ([]*models.User, error) {
+	ret := _m.Called(ids)
+
+	var r0 []*models.User
+	if rf, ok := ret.Get(0).(func([]string) []*models.User); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUsername provides a mock function with given fields: username
+func (_m *Repository) GetByUsername(username string) (*models.User, error) {
+	ret := _m.Called(username)
+
+	var r0 *models.User
+	if rf, ok := ret.Get(0).(func(string) *models.User); ok {
+		r0 = rf(username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsIDExist provides a mock function with given fields: id
 func (_m *Repository) IsIDExist(id string) (bool, error) {
 	ret := _m.Called(id)
@@ -92,6 +92,52 @@ func (_m *Usecase) For(_a0 *models.User) user.InstanceUsecase {
 	return r0
 }
 
+// GetProfile provides a mock function with given fields: viewerID, userID
+func (_m *Usecase) GetProfile(viewerID string, userID string) (*models.UserProfile, error) {
+	ret := _m.Called(viewerID, userID)
+
+	var r0 *models.UserProfile
+	if rf, ok := ret.Get(0).(func(string, string) *models.UserProfile); ok {
+		r0 = rf(viewerID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserProfile)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(viewerID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProfileByUsername provides a mock function with given fields: viewerID, username
+func (_m *Usecase) GetProfileByUsername(viewerID string, username string) (*models.UserProfile, error) {
+	ret := _m.Called(viewerID, username)
+
+	var r0 *models.UserProfile
+	if rf, ok := ret.Get(0).(func(string, string) *models.UserProfile); ok {
+		r0 = rf(viewerID, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserProfile)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(viewerID, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Login provides a mock function with given fields: login, password
 func (_m *Usecase) Login(login string, password string) (map[string]interface{}, error) {
 	ret := _m.Called(login, password)
@@ -1,9 +1,12 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/jordyf15/tweeter-api/models"
 	"github.com/jordyf15/tweeter-api/user"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type userRepository struct {
@@ -42,6 +45,31 @@ func (repo *userRepository) GetByID(id string) (*models.User, error) {
 	return user, nil
 }
 
+func (repo *userRepository) GetByIDs(ids []string) ([]*models.User, error) {
+	users := make([]*models.User, 0)
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	err := repo.DB.Table("users").Where("id IN ?", ids).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (repo *userRepository) GetByUsername(username string) (*models.User, error) {
+	user := &models.User{}
+
+	err := repo.DB.Table("users").Where("username = ?", username).First(user).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (repo *userRepository) Update(user *models.User) error {
 	return repo.DB.Model(user).Select("*").Updates(user).Error
 }
@@ -51,3 +79,30 @@ func (repo *userRepository) IsIDExist(userID string) (bool, error) {
 	err := repo.DB.Table("users").Where("id = (?)", userID).Count(&count).Error
 	return count > 0, err
 }
+
+// ApproveAllFollowRequests turns every pending follow request sent to the user into a follow and returns the IDs of the new followers.
+func (repo *userRepository) ApproveAllFollowRequests(userID string) ([]string, error) {
+	followerIDs := make([]string, 0)
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw("DELETE FROM follow_requests WHERE following_id = ? RETURNING follower_id", userID).Scan(&followerIDs).Error
+		if err != nil {
+			return err
+		}
+
+		if len(followerIDs) == 0 {
+			return nil
+		}
+
+		follows := make([]*models.Follow, 0, len(followerIDs))
+		for _, followerID := range followerIDs {
+			follows = append(follows, &models.Follow{FollowerID: followerID, FollowingID: userID, CreatedAt: time.Now()})
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&follows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return followerIDs, nil
+}
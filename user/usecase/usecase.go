@@ -3,13 +3,17 @@ package usecase
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jordyf15/tweeter-api/custom_errors"
+	"github.com/jordyf15/tweeter-api/follow"
 	"github.com/jordyf15/tweeter-api/models"
 	"github.com/jordyf15/tweeter-api/storage"
+	"github.com/jordyf15/tweeter-api/timeline"
 	"github.com/jordyf15/tweeter-api/token"
 	"github.com/jordyf15/tweeter-api/user"
 	"github.com/jordyf15/tweeter-api/utils"
@@ -17,9 +21,11 @@ import (
 )
 
 type userUsecase struct {
-	userRepo  user.Repository
-	tokenRepo token.Repository
-	storage   storage.Storage
+	userRepo     user.Repository
+	tokenRepo    token.Repository
+	followRepo   follow.Repository
+	timelineRepo timeline.Repository
+	storage      storage.Storage
 }
 
 type userInstanceUsecase struct {
@@ -27,8 +33,8 @@ type userInstanceUsecase struct {
 	userUsecase
 }
 
-func NewUserUsecase(userRepo user.Repository, tokenRepo token.Repository, storage storage.Storage) user.Usecase {
-	return &userUsecase{userRepo: userRepo, tokenRepo: tokenRepo, storage: storage}
+func NewUserUsecase(userRepo user.Repository, tokenRepo token.Repository, followRepo follow.Repository, timelineRepo timeline.Repository, storage storage.Storage) user.Usecase {
+	return &userUsecase{userRepo: userRepo, tokenRepo: tokenRepo, followRepo: followRepo, timelineRepo: timelineRepo, storage: storage}
 }
 
 func (usecase *userUsecase) For(user *models.User) user.InstanceUsecase {
@@ -133,7 +139,7 @@ func (usecase *userUsecase) Create(_user *models.User) (map[string]interface{},
 
 	accessToken, refreshToken, _ := usecase.For(_user).GenerateTokens()
 
-	response := utils.DataResponse(_user, map[string]interface{}{
+	response := utils.DataResponse(&models.CurrentUser{User: _user, Email: _user.Email}, map[string]interface{}{
 		"access_token":  accessToken.ToJWTString(),
 		"refresh_token": refreshToken.ToJWTString(),
 		"expires_at":    accessToken.ExpiresAt,
@@ -163,7 +169,7 @@ func (usecase *userUsecase) Login(login, password string) (map[string]interface{
 
 	usecase.storage.AssignImageURLToUser(user)
 
-	response := utils.DataResponse(user, map[string]interface{}{
+	response := utils.DataResponse(&models.CurrentUser{User: user, Email: user.Email}, map[string]interface{}{
 		"access_token":  accessToken.ToJWTString(),
 		"refresh_token": refreshToken.ToJWTString(),
 		"expires_at":    accessToken.ExpiresAt,
@@ -196,6 +202,16 @@ func (usecase *userUsecase) EditUserProfile(userID string, updates map[string]st
 		_user.Description = newDescription
 	}
 
+	wasProtected := _user.IsProtected
+	if newIsProtected, isExist := updates["is_protected"]; isExist {
+		isProtected, err := strconv.ParseBool(newIsProtected)
+		if err != nil {
+			errors = append(errors, custom_errors.ErrIsProtectedInvalid)
+		}
+
+		_user.IsProtected = isProtected
+	}
+
 	validateFieldErrors := _user.VerifyFields()
 	if len(validateFieldErrors) > 0 {
 		errors = append(errors, validateFieldErrors...)
@@ -285,12 +301,21 @@ func (usecase *userUsecase) EditUserProfile(userID string, updates map[string]st
 		}
 	}
 
+	var followerIDs []string
 	err = usecase.userRepo.CreateTransaction(func(repo user.Repository) error {
-		err = usecase.userRepo.Update(_user)
+		err := repo.Update(_user)
 		if err != nil {
 			return err
 		}
 
+		// once the account is public there is nothing left to approve, so pending requesters become followers
+		if wasProtected && !_user.IsProtected {
+			followerIDs, err = repo.ApproveAllFollowRequests(_user.ID)
+			if err != nil {
+				return err
+			}
+		}
+
 		var wg sync.WaitGroup
 		fileStorageChannelSizes := 0
 
@@ -360,6 +385,13 @@ func (usecase *userUsecase) EditUserProfile(userID string, updates map[string]st
 		return nil, &custom_errors.MultipleErrors{Errors: errors}
 	}
 
+	for _, followerID := range followerIDs {
+		err = usecase.timelineRepo.DeleteHomeTimeline(followerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	usecase.storage.AssignImageURLToUser(_user)
 
 	return _user, nil
@@ -392,6 +424,49 @@ func (usecase *userUsecase) ChangeUserPassword(userId, oldPassword, newPassword
 	return nil
 }
 
+func (usecase *userUsecase) GetProfile(viewerID, userID string) (*models.UserProfile, error) {
+	_user, err := usecase.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.buildProfile(viewerID, _user)
+}
+
+func (usecase *userUsecase) GetProfileByUsername(viewerID, username string) (*models.UserProfile, error) {
+	_user, err := usecase.userRepo.GetByUsername(strings.ToLower(username))
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.buildProfile(viewerID, _user)
+}
+
+// buildProfile computes the relationship flags between the viewer and the user.
+// The email is only kept when the viewer is the owner of the profile.
+func (usecase *userUsecase) buildProfile(viewerID string, _user *models.User) (*models.UserProfile, error) {
+	usecase.storage.AssignImageURLToUser(_user)
+
+	profile := &models.UserProfile{User: _user}
+	if viewerID == _user.ID {
+		profile.Email = _user.Email
+		return profile, nil
+	}
+
+	var err error
+	profile.IsFollowing, err = usecase.followRepo.IsFollowing(viewerID, _user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.FollowsYou, err = usecase.followRepo.IsFollowing(_user.ID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
 func (usecase *userInstanceUsecase) GenerateTokens() (*models.AccessToken, *models.RefreshToken, error) {
 	refreshToken := (&models.RefreshToken{UserID: usecase.user.ID})
 	refreshToken.Id = utils.RandString(8)
@@ -6,8 +6,10 @@ import (
 	"testing"
 
 	"github.com/jordyf15/tweeter-api/custom_errors"
+	followMocks "github.com/jordyf15/tweeter-api/follow/mocks"
 	"github.com/jordyf15/tweeter-api/models"
 	storageMocks "github.com/jordyf15/tweeter-api/storage/mocks"
+	timelineMocks "github.com/jordyf15/tweeter-api/timeline/mocks"
 	tokenMocks "github.com/jordyf15/tweeter-api/token/mocks"
 	"github.com/jordyf15/tweeter-api/user"
 	userMocks "github.com/jordyf15/tweeter-api/user/mocks"
@@ -17,6 +19,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 func TestUserUsecase(t *testing.T) {
@@ -25,10 +28,12 @@ func TestUserUsecase(t *testing.T) {
 
 type userUsecaseSuite struct {
 	suite.Suite
-	usecase     user.Usecase
-	userRepo    *userMocks.Repository
-	tokenRepo   *tokenMocks.Repository
-	storageMock *storageMocks.Storage
+	usecase      user.Usecase
+	userRepo     *userMocks.Repository
+	tokenRepo    *tokenMocks.Repository
+	followRepo   *followMocks.Repository
+	timelineRepo *timelineMocks.Repository
+	storageMock  *storageMocks.Storage
 }
 
 var (
@@ -73,6 +78,8 @@ func (s *userUsecaseSuite) SetupTest() {
 
 	s.userRepo = new(userMocks.Repository)
 	s.tokenRepo = new(tokenMocks.Repository)
+	s.followRepo = new(followMocks.Repository)
+	s.timelineRepo = new(timelineMocks.Repository)
 	s.storageMock = new(storageMocks.Storage)
 
 	s.storageMock.On("GetFileLink", mock.AnythingOfType("string")).Return("string", nil)
@@ -100,9 +107,26 @@ func (s *userUsecaseSuite) SetupTest() {
 			return utUser2
 		}
 	}, nil)
+	s.userRepo.On("GetByUsername", mock.AnythingOfType("string")).Return(func(username string) *models.User {
+		if username == "gura" {
+			return utUser1
+		}
+
+		return nil
+	}, func(username string) error {
+		if username == "gura" {
+			return nil
+		}
+
+		return gorm.ErrRecordNotFound
+	})
 	s.userRepo.On("Update", mock.AnythingOfType("*models.User")).Return(nil)
+	s.followRepo.On("IsFollowing", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(func(followerID, followingID string) bool {
+		return followerID == "id2" && followingID == "id1"
+	}, nil)
+	s.timelineRepo.On("DeleteHomeTimeline", mock.AnythingOfType("string")).Return(nil)
 
-	s.usecase = usecase.NewUserUsecase(s.userRepo, s.tokenRepo, s.storageMock)
+	s.usecase = usecase.NewUserUsecase(s.userRepo, s.tokenRepo, s.followRepo, s.timelineRepo, s.storageMock)
 }
 
 func (s *userUsecaseSuite) TestCreateUsernameTooShort() {
@@ -279,7 +303,7 @@ func (s *userUsecaseSuite) TestCreateSuccessful() {
 
 	assert.NoError(s.T(), err)
 
-	data, isExist := result["data"].(*models.User)
+	data, isExist := result["data"].(*models.CurrentUser)
 	assert.True(s.T(), isExist)
 	assert.Equal(s.T(), utUser1.Email, data.Email)
 	assert.Equal(s.T(), utUser1.Fullname, data.Fullname)
@@ -321,7 +345,7 @@ func (s *userUsecaseSuite) TestLoginSuccessful() {
 	response, err := s.usecase.Login("gura", "Password123!")
 	assert.NoError(s.T(), err)
 
-	data, isExist := response["data"].(*models.User)
+	data, isExist := response["data"].(*models.CurrentUser)
 	assert.True(s.T(), isExist)
 	assert.Equal(s.T(), utUser1.Email, data.Email)
 	assert.Equal(s.T(), utUser1.Fullname, data.Fullname)
@@ -539,3 +563,92 @@ func (s *userUsecaseSuite) TestEditUserProfileSuccessful() {
 	s.userRepo.AssertNumberOfCalls(s.T(), "GetByID", 1)
 	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToUser", 1)
 }
+
+func (s *userUsecaseSuite) TestEditUserProfileProtect() {
+	updates := map[string]string{
+		"is_protected": "true",
+	}
+
+	user, err := s.usecase.EditUserProfile(utUser1.ID, updates, nil, nil, false, false)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), user.IsProtected)
+	s.userRepo.AssertNumberOfCalls(s.T(), "ApproveAllFollowRequests", 0)
+}
+
+func (s *userUsecaseSuite) TestEditUserProfileIsProtectedInvalid() {
+	updates := map[string]string{
+		"is_protected": "yes",
+	}
+
+	user, err := s.usecase.EditUserProfile(utUser1.ID, updates, nil, nil, false, false)
+
+	expectedErrors := &custom_errors.MultipleErrors{Errors: []error{custom_errors.ErrIsProtectedInvalid}}
+	assert.Nil(s.T(), user)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), expectedErrors.Error(), err.Error())
+	s.userRepo.AssertNumberOfCalls(s.T(), "CreateTransaction", 0)
+}
+
+func (s *userUsecaseSuite) TestEditUserProfileUnprotectApprovesFollowRequests() {
+	utUser1.IsProtected = true
+	updates := map[string]string{
+		"is_protected": "false",
+	}
+
+	userRepo := new(userMocks.Repository)
+	userRepo.On("GetByID", mock.AnythingOfType("string")).Return(utUser1, nil)
+	userRepo.On("CreateTransaction", mock.Anything).Return(func(fn func(repo user.Repository) error) error {
+		return fn(userRepo)
+	})
+	userRepo.On("Update", mock.AnythingOfType("*models.User")).Return(nil)
+	userRepo.On("ApproveAllFollowRequests", mock.AnythingOfType("string")).Return([]string{"id2"}, nil)
+	_usecase := usecase.NewUserUsecase(userRepo, s.tokenRepo, s.followRepo, s.timelineRepo, s.storageMock)
+
+	user, err := _usecase.EditUserProfile(utUser1.ID, updates, nil, nil, false, false)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), user.IsProtected)
+	userRepo.AssertCalled(s.T(), "Update", utUser1)
+	userRepo.AssertCalled(s.T(), "ApproveAllFollowRequests", "id1")
+	s.timelineRepo.AssertCalled(s.T(), "DeleteHomeTimeline", "id2")
+}
+
+func (s *userUsecaseSuite) TestGetProfileOwner() {
+	profile, err := s.usecase.GetProfile("id1", "id1")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "gura@gmail.com", profile.Email)
+	assert.False(s.T(), profile.IsFollowing)
+	assert.False(s.T(), profile.FollowsYou)
+	s.followRepo.AssertNumberOfCalls(s.T(), "IsFollowing", 0)
+	s.storageMock.AssertNumberOfCalls(s.T(), "AssignImageURLToUser", 1)
+}
+
+func (s *userUsecaseSuite) TestGetProfileOtherViewer() {
+	profile, err := s.usecase.GetProfile("id2", "id1")
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), profile.Email)
+	assert.True(s.T(), profile.IsFollowing)
+	assert.False(s.T(), profile.FollowsYou)
+	s.followRepo.AssertCalled(s.T(), "IsFollowing", "id2", "id1")
+	s.followRepo.AssertCalled(s.T(), "IsFollowing", "id1", "id2")
+}
+
+func (s *userUsecaseSuite) TestGetProfileByUsernameNotFound() {
+	profile, err := s.usecase.GetProfileByUsername("id2", "fubuki")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), profile)
+	assert.Equal(s.T(), gorm.ErrRecordNotFound, err)
+}
+
+func (s *userUsecaseSuite) TestGetProfileByUsernameSuccessful() {
+	profile, err := s.usecase.GetProfileByUsername("id2", "Gura")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "id1", profile.ID)
+	assert.True(s.T(), profile.IsFollowing)
+	s.userRepo.AssertCalled(s.T(), "GetByUsername", "gura")
+}
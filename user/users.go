@@ -0,0 +1,24 @@
+package user
+
+import (
+	"github.com/jordyf15/tweeter-api/models"
+	"github.com/jordyf15/tweeter-api/storage"
+)
+
+// GetUsersByID loads the users with the given IDs keyed by their ID, with their image URLs assigned.
+// The emails are cleared as the users are loaded to be shown to other users.
+func GetUsersByID(userRepo Repository, storage storage.Storage, userIDs []string) (map[string]*models.User, error) {
+	users, err := userRepo.GetByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID := make(map[string]*models.User, len(users))
+	for _, _user := range users {
+		_user.Email = ""
+		storage.AssignImageURLToUser(_user)
+		usersByID[_user.ID] = _user
+	}
+
+	return usersByID, nil
+}
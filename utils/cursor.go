@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jordyf15/tweeter-api/custom_errors"
+)
+
+// Cursor points at the last item of a page ordered by (created_at, id). Pages ordered by a count
+// first, such as groups sorted by member count, also carry the count of that item.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+	Count     *uint
+}
+
+func NewCursor(createdAt time.Time, id string) *Cursor {
+	return &Cursor{CreatedAt: createdAt, ID: id}
+}
+
+func NewCountCursor(count uint, createdAt time.Time, id string) *Cursor {
+	return &Cursor{CreatedAt: createdAt, ID: id, Count: &count}
+}
+
+func (cursor *Cursor) Encode() string {
+	header := strconv.FormatInt(cursor.CreatedAt.UnixNano(), 10)
+	if cursor.Count != nil {
+		header += "," + strconv.FormatUint(uint64(*cursor.Count), 10)
+	}
+
+	raw := header + "|" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeCursor(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, custom_errors.ErrInvalidCursor
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || len(parts[1]) == 0 {
+		return nil, custom_errors.ErrInvalidCursor
+	}
+
+	header := strings.Split(parts[0], ",")
+	if len(header) > 2 {
+		return nil, custom_errors.ErrInvalidCursor
+	}
+
+	nanoseconds, err := strconv.ParseInt(header[0], 10, 64)
+	if err != nil {
+		return nil, custom_errors.ErrInvalidCursor
+	}
+
+	cursor := &Cursor{CreatedAt: time.Unix(0, nanoseconds), ID: parts[1]}
+	if len(header) == 2 {
+		count, err := strconv.ParseUint(header[1], 10, 0)
+		if err != nil {
+			return nil, custom_errors.ErrInvalidCursor
+		}
+
+		cursorCount := uint(count)
+		cursor.Count = &cursorCount
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxHashtagLength = 30
+
+var hashtagRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&#])#([\p{L}\p{N}_]+)`)
+var digitsOnlyRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+// ExtractHashtags returns the distinct lowercased hashtags found in text, in order of appearance.
+// Tags made of digits only or longer than 30 characters are ignored.
+func ExtractHashtags(text string) []string {
+	hashtags := make([]string, 0)
+	isFound := make(map[string]bool)
+
+	for _, match := range hashtagRegexp.FindAllStringSubmatch(text, -1) {
+		name := strings.ToLower(match[1])
+		if utf8.RuneCountInString(name) > maxHashtagLength || digitsOnlyRegexp.MatchString(name) || isFound[name] {
+			continue
+		}
+
+		isFound[name] = true
+		hashtags = append(hashtags, name)
+	}
+
+	return hashtags
+}
@@ -28,3 +28,15 @@ func ResizeImage(reader NamedFileReader, width int, height int) (*os.File, error
 	dst := imaging.Fill(image, width, height, imaging.Center, imaging.Lanczos)
 	return tmpFile, imaging.Save(dst, tmpFile.Name())
 }
+
+// IsImageFormatValid reports whether all the images are jpg, jpeg or png files.
+func IsImageFormatValid(imageReaders []NamedFileReader) bool {
+	for _, imageReader := range imageReaders {
+		extension := GetFileExtension(imageReader.Name())
+		if extension != "jpg" && extension != "jpeg" && extension != "png" {
+			return false
+		}
+	}
+
+	return true
+}